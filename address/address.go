@@ -0,0 +1,90 @@
+/*
+国内所在地を扱うパッケージです。
+
+法人番号システム Web-API の丁目番地等(streetNumber)は全角で提供されるため,
+利用者が入力した住所とそのまま比較すると一致しません。
+このパッケージでは住所の正規化, 丁目番地等の分割, 2 つの住所が同じ所在地を指すかの判定をサポートします。
+
+	// corporation の丁目番地等は "八島町５８番地１ウエストワンビル１０Ｆ１０１１号"
+	a := corporation.Address()
+	b := address.Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58-1 ウエストワンビル 10F1011号"}
+	address.Equivalent(a, b) // true
+	c := address.Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58-1"}
+	address.Equivalent(a, c) // true(建物名等が片方にしかない場合は比較しない)
+	d := address.Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58-1 ウエストワンビル 10F"}
+	address.Equivalent(a, d) // false(建物名等が異なる)
+*/
+package address
+
+import (
+	"strings"
+
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// Address は国内所在地です。
+type Address struct {
+	// 郵便番号
+	PostCode string
+	// 都道府県
+	Prefecture string
+	// 市区町村
+	City string
+	// 丁目番地等
+	Street string
+}
+
+/*
+Normalized は各項目を正規化した所在地を返します。
+
+郵便番号は数字のみにし, 都道府県, 市区町村, 丁目番地等は Normalize で正規化します。
+*/
+func (a Address) Normalized() Address {
+	return Address{
+		PostCode:   NormalizePostCode(a.PostCode),
+		Prefecture: Normalize(a.Prefecture),
+		City:       Normalize(a.City),
+		Street:     ParseStreet(a.Street).String(),
+	}
+}
+
+// String は都道府県, 市区町村, 丁目番地等をつないだ所在地を返します。
+func (a Address) String() string {
+	return a.Prefecture + a.City + a.Street
+}
+
+/*
+Equivalent は 2 つの所在地が同じ所在地を指すか判定します。
+
+正規化した都道府県, 市区町村, 町名, 丁目, 番地, 号を比較します。
+郵便番号と建物名等は両方に値がある場合のみ比較し, 建物名等の空白の有無は無視します。
+*/
+func Equivalent(a, b Address) bool {
+	na, nb := a.Normalized(), b.Normalized()
+	if na.PostCode != "" && nb.PostCode != "" && na.PostCode != nb.PostCode {
+		return false
+	}
+	if na.Prefecture != nb.Prefecture || na.City != nb.City {
+		return false
+	}
+
+	sa, sb := ParseStreet(a.Street), ParseStreet(b.Street)
+	if sa.Town != sb.Town || sa.Chome != sb.Chome || sa.Banchi != sb.Banchi || sa.Go != sb.Go {
+		return false
+	}
+	if sa.Building == "" || sb.Building == "" {
+		return true
+	}
+	return strings.ReplaceAll(sa.Building, " ", "") == strings.ReplaceAll(sb.Building, " ", "")
+}
+
+// NormalizePostCode は郵便番号から数字以外を取り除きます(例: 〒３７０−００６９ → 3700069)。
+func NormalizePostCode(s string) string {
+	var b strings.Builder
+	for _, r := range normalize.Width(s) {
+		if isDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package address
+
+import "testing"
+
+func TestEquivalent(t *testing.T) {
+	base := Address{PostCode: "3700849", Prefecture: "群馬県", City: "高崎市", Street: "八島町５８番地１ウエストワンビル１０Ｆ１０１１号"}
+
+	tests := map[string]struct {
+		other    Address
+		expected bool
+	}{
+		"same":               {base, true},
+		"half width":         {Address{PostCode: "370-0849", Prefecture: "群馬県", City: "高崎市", Street: "八島町58-1 ウエストワンビル 10F1011号"}, true},
+		"without building":   {Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58番地1"}, true},
+		"kanji numerals":     {Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町五十八番地一 ウエストワンビル10F1011号"}, true},
+		"different go":       {Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58番地2"}, false},
+		"different room":     {Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58-1 ウエストワンビル 10F"}, false},
+		"different building": {Address{Prefecture: "群馬県", City: "高崎市", Street: "八島町58番地1 イーストビル"}, false},
+		"different city":     {Address{Prefecture: "群馬県", City: "前橋市", Street: "八島町58番地1"}, false},
+		"different postcode": {Address{PostCode: "3700069", Prefecture: "群馬県", City: "高崎市", Street: "八島町58番地1"}, false},
+	}
+
+	for name, tt := range tests {
+		if result := Equivalent(base, tt.other); result != tt.expected {
+			t.Errorf("%s: result:%v expected:%v", name, result, tt.expected)
+		}
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	a := Address{PostCode: "〒370-0849", Prefecture: "群馬県", City: "高崎市", Street: "八島町５８番地１"}
+	expected := Address{PostCode: "3700849", Prefecture: "群馬県", City: "高崎市", Street: "八島町58番地1"}
+	if result := a.Normalized(); result != expected {
+		t.Errorf("result:%+v expected:%+v", result, expected)
+	}
+	if a.String() != "群馬県高崎市八島町５８番地１" {
+		t.Errorf("String is wrong. result:%s", a.String())
+	}
+}
@@ -0,0 +1,54 @@
+package address
+
+import "strings"
+
+/*
+FormatPostCode は郵便番号を「〒370-0849」の形式に変換します。
+
+全角やハイフンの有無にかかわらず数字が 7 桁の場合のみ変換し, それ以外の場合は空文字を返します。
+*/
+func FormatPostCode(s string) string {
+	code := NormalizePostCode(s)
+	if len(code) != 7 {
+		return ""
+	}
+	return "〒" + code[:3] + "-" + code[3:]
+}
+
+/*
+OneLine は郵便番号と所在地を 1 行にまとめた文字列を返します。
+
+	〒370-0849 群馬県高崎市八島町58番地1 ウエストワンビル10F1011号
+
+丁目番地等は正規化し, 郵便番号がない場合は所在地のみを返します。
+*/
+func (a Address) OneLine() string {
+	return strings.Join(a.Lines(), " ")
+}
+
+/*
+Lines は郵便番号, 所在地, 建物名等を行ごとに分けて返します。宛名の印字に利用できます。
+
+	〒370-0849
+	群馬県高崎市八島町58番地1
+	ウエストワンビル10F1011号
+
+丁目番地等は正規化し, 値のない行は含みません。
+*/
+func (a Address) Lines() []string {
+	var lines []string
+	if code := FormatPostCode(a.PostCode); code != "" {
+		lines = append(lines, code)
+	}
+
+	street := ParseStreet(a.Street)
+	building := street.Building
+	street.Building = ""
+	if s := Normalize(a.Prefecture) + Normalize(a.City) + street.String(); s != "" {
+		lines = append(lines, s)
+	}
+	if building != "" {
+		lines = append(lines, building)
+	}
+	return lines
+}
@@ -0,0 +1,42 @@
+package address
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormatPostCode(t *testing.T) {
+	for input, expected := range map[string]string{
+		"3700849":  "〒370-0849",
+		"370-0849": "〒370-0849",
+		"３７００８４９":  "〒370-0849",
+		"370084":   "",
+		"":         "",
+	} {
+		if result := FormatPostCode(input); result != expected {
+			t.Errorf("%s: result:%s expected:%s", input, result, expected)
+		}
+	}
+}
+
+func TestLines(t *testing.T) {
+	a := Address{PostCode: "3700849", Prefecture: "群馬県", City: "高崎市", Street: "八島町５８番地１ウエストワンビル１０Ｆ１０１１号"}
+
+	expected := []string{"〒370-0849", "群馬県高崎市八島町58番地1", "ウエストワンビル10F1011号"}
+	if result := a.Lines(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Lines is wrong. result:%v expected:%v", result, expected)
+	}
+
+	if result := a.OneLine(); result != "〒370-0849 群馬県高崎市八島町58番地1 ウエストワンビル10F1011号" {
+		t.Errorf("OneLine is wrong. result:%s", result)
+	}
+
+	a = Address{Prefecture: "群馬県", City: "前橋市", Street: "大手町１丁目１番１号"}
+	if result := a.OneLine(); result != "群馬県前橋市大手町1丁目1番1号" {
+		t.Errorf("OneLine is wrong. result:%s", result)
+	}
+
+	if result := (Address{}).Lines(); len(result) != 0 {
+		t.Errorf("Lines is wrong. result:%v", result)
+	}
+}
@@ -0,0 +1,250 @@
+package address
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+/*
+廃置分合等による団体コードの変更の一覧です。
+
+1 行が廃止された団体コードと承継した団体コードの 1 組で,
+区域が複数に分かれた場合は承継した団体コードごとに行を分けます。
+
+	date,type,code,name,successor_code,successor_name
+	2024-01-01,ward,221350,北区,221384,中央区
+	2024-01-01,ward,221350,北区,221392,浜名区
+
+type は merger(新設合併), absorption(編入合併), designation(政令指定都市への移行),
+status(市制・町制の施行), ward(政令指定都市の区の再編)のいずれかです。
+
+internal/cmd/genmunicipality に -changes を指定して総務省の廃置分合等の一覧から生成します。
+*/
+//go:embed data/municipality_changes.csv
+var municipalityChangesCSV string
+
+// 平成の合併(新設合併・編入合併)を含め, 廃置分合等をすべて収録している都道府県
+var historyPrefectures = map[uint8]bool{
+	// 群馬県
+	10: true,
+}
+
+var (
+	municipalityChanges []MunicipalityChange
+	// 廃止された団体コードから変更への対応
+	changesByCode map[string][]MunicipalityChange
+	// 承継した団体コードから変更への対応
+	changesBySuccessor map[string][]MunicipalityChange
+)
+
+// ChangeType は団体コードの変更の種類です。
+type ChangeType string
+
+const (
+	// 新設合併
+	ChangeMerger ChangeType = "merger"
+	// 編入合併
+	ChangeAbsorption ChangeType = "absorption"
+	// 政令指定都市への移行
+	ChangeDesignation ChangeType = "designation"
+	// 市制・町制の施行
+	ChangeStatus ChangeType = "status"
+	// 政令指定都市の区の再編
+	ChangeWard ChangeType = "ward"
+)
+
+var changeTypeNames = map[ChangeType]string{
+	ChangeMerger:      "新設合併",
+	ChangeAbsorption:  "編入合併",
+	ChangeDesignation: "政令指定都市への移行",
+	ChangeStatus:      "市制・町制の施行",
+	ChangeWard:        "区の再編",
+}
+
+// String は変更の種類の名称を返します。
+func (t ChangeType) String() string {
+	return changeTypeNames[t]
+}
+
+// MunicipalityChange は廃置分合等による団体コードの変更です。
+type MunicipalityChange struct {
+	// 変更年月日
+	Date time.Time
+	// 変更の種類
+	Type ChangeType
+	// 廃止された団体コード(5 桁)
+	Code string
+	// 廃止された市区町村の名称
+	Name string
+	// 承継した団体コード(5 桁)
+	SuccessorCode string
+	// 承継した市区町村の名称
+	SuccessorName string
+}
+
+func init() {
+	r := csv.NewReader(strings.NewReader(municipalityChangesCSV))
+	rows, err := r.ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("address: failed to read municipality changes: %v", err))
+	}
+
+	changesByCode = map[string][]MunicipalityChange{}
+	changesBySuccessor = map[string][]MunicipalityChange{}
+	// 1 行目は見出し
+	for _, row := range rows[1:] {
+		date, err := time.Parse("2006-01-02", row[0])
+		if err != nil {
+			panic(fmt.Sprintf("address: invalid municipality change date: %s", row[0]))
+		}
+		c := MunicipalityChange{
+			Date:          date,
+			Type:          ChangeType(row[1]),
+			Code:          row[2][:5],
+			Name:          row[3],
+			SuccessorCode: row[4][:5],
+			SuccessorName: row[5],
+		}
+		municipalityChanges = append(municipalityChanges, c)
+		changesByCode[c.Code] = append(changesByCode[c.Code], c)
+		changesBySuccessor[c.SuccessorCode] = append(changesBySuccessor[c.SuccessorCode], c)
+	}
+}
+
+/*
+MunicipalityChanges は団体コードの変更の一覧を変更年月日の順に返します。
+
+政令指定都市への移行, 市制の施行, 区の再編の一部と, 群馬県の平成の合併(新設合併・編入合併)のみを収録しています。
+その他の都道府県の合併(例: 浦和市, 大宮市, 与野市 → さいたま市)は含まないため,
+ResolveMunicipalityCode, FormerMunicipalities, SameMunicipality はそれらの団体コードを変換・比較できません。
+すべての変更を収録している都道府県かは HasMunicipalityHistory で確認できます。
+*/
+func MunicipalityChanges() []MunicipalityChange {
+	list := append([]MunicipalityChange{}, municipalityChanges...)
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Date.Before(list[j].Date)
+	})
+	return list
+}
+
+/*
+HasMunicipalityHistory は都道府県の団体コードの変更をすべて収録しているか判定します。
+
+false の場合, その都道府県の廃止された団体コードは MunicipalityChanges に含まれないことがあります。
+*/
+func HasMunicipalityHistory(prefCode uint8) bool {
+	return historyPrefectures[prefCode]
+}
+
+/*
+ResolveMunicipalityCode は団体コードを at の時点で有効な団体コード(5 桁)に変換します。
+
+合併等で廃止された団体コードは承継した団体コードを変更年月日の順にたどります。
+区域が複数に分かれた場合は複数の団体コードを返します。
+at がゼロ値の場合はすべての変更を反映した現在の団体コードを返します。
+変更のない団体コードと収録していない変更で廃止された団体コード(MunicipalityChanges を参照)はそのまま返し,
+5 桁または 6 桁の団体コードでない場合は nil を返します。
+
+	ResolveMunicipalityCode("22202", time.Time{}) // [22130](浜松市)
+	ResolveMunicipalityCode("10361", time.Time{}) // [10202](新町 → 高崎市)
+	ResolveMunicipalityCode("22135", time.Time{}) // [22138 22139](浜松市北区 → 中央区, 浜名区)
+*/
+func ResolveMunicipalityCode(code string, at time.Time) []string {
+	code, ok := localGovCode(code)
+	if !ok {
+		return nil
+	}
+
+	var codes []string
+	seen := map[string]bool{}
+	var resolve func(code string, since time.Time)
+	resolve = func(code string, since time.Time) {
+		if seen[code] {
+			return
+		}
+		seen[code] = true
+
+		next := false
+		for _, c := range changesByCode[code] {
+			if c.Date.Before(since) || (!at.IsZero() && c.Date.After(at)) {
+				continue
+			}
+			next = true
+			resolve(c.SuccessorCode, c.Date)
+		}
+		if !next {
+			codes = append(codes, code)
+		}
+	}
+	resolve(code, time.Time{})
+
+	sort.Strings(codes)
+	return codes
+}
+
+/*
+FormerMunicipalities は団体コードが承継した廃止済みの団体コードの変更を変更年月日の順に返します。
+
+合併等が繰り返された場合はさかのぼってすべての変更を返します。
+*/
+func FormerMunicipalities(code string) []MunicipalityChange {
+	code, ok := localGovCode(code)
+	if !ok {
+		return nil
+	}
+
+	var list []MunicipalityChange
+	seen := map[string]bool{code: true}
+	queue := []string{code}
+	for len(queue) > 0 {
+		code := queue[0]
+		queue = queue[1:]
+		for _, c := range changesBySuccessor[code] {
+			list = append(list, c)
+			if !seen[c.Code] {
+				seen[c.Code] = true
+				queue = append(queue, c.Code)
+			}
+		}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Date.Before(list[j].Date)
+	})
+	return list
+}
+
+/*
+SameMunicipality は 2 つの団体コードが合併等を反映した現在の団体コードで同じ市区町村を指すか判定します。
+
+区域が複数に分かれた団体コードは, 承継した団体コードのいずれかが一致する場合に同じとみなします。
+*/
+func SameMunicipality(a, b string) bool {
+	codes := ResolveMunicipalityCode(b, time.Time{})
+	for _, x := range ResolveMunicipalityCode(a, time.Time{}) {
+		for _, y := range codes {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// localGovCode は 5 桁または検査数字を含む 6 桁の団体コードを 5 桁にします。
+func localGovCode(code string) (string, bool) {
+	if len(code) == 6 {
+		if !ValidLocalGovCode(code) {
+			return "", false
+		}
+		code = code[:5]
+	}
+	if len(code) != 5 || !isDigits(code) {
+		return "", false
+	}
+	return code, true
+}
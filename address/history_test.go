@@ -0,0 +1,121 @@
+package address
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMunicipalityChanges(t *testing.T) {
+	list := MunicipalityChanges()
+	if len(list) == 0 {
+		t.Fatal("list is empty.")
+	}
+	for i, c := range list {
+		if i > 0 && c.Date.Before(list[i-1].Date) {
+			t.Errorf("%s: order is wrong.", c.Code)
+		}
+		if c.Type.String() == "" || c.Name == "" || c.SuccessorName == "" {
+			t.Errorf("%s: field is empty. %+v", c.Code, c)
+		}
+		if _, ok := MunicipalityByLocalGovCode(c.Code); ok {
+			t.Errorf("%s: abolished code exists.", c.Code)
+		}
+		for _, code := range ResolveMunicipalityCode(c.SuccessorCode, time.Time{}) {
+			if _, ok := MunicipalityByLocalGovCode(code); !ok {
+				t.Errorf("%s: successor %s is not found.", c.Code, code)
+			}
+		}
+	}
+}
+
+func TestResolveMunicipalityCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		at       time.Time
+		expected []string
+	}{
+		{"43201", time.Time{}, []string{"43100"}},
+		{"432016", time.Time{}, []string{"43100"}},
+		{"22202", time.Time{}, []string{"22130"}},
+		{"22135", time.Time{}, []string{"22138", "22139"}},
+		{"22135", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), []string{"22135"}},
+		{"22135", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []string{"22138", "22139"}},
+		{"43201", time.Date(2012, 3, 31, 0, 0, 0, 0, time.UTC), []string{"43201"}},
+		{"10202", time.Time{}, []string{"10202"}},
+		// 編入合併(新町 → 高崎市)
+		{"10361", time.Time{}, []string{"10202"}},
+		{"103616", time.Date(2006, 1, 23, 0, 0, 0, 0, time.UTC), []string{"10202"}},
+		{"10361", time.Date(2006, 1, 22, 0, 0, 0, 0, time.UTC), []string{"10361"}},
+		// 新設合併(万場町 → 神流町)
+		{"10364", time.Time{}, []string{"10367"}},
+		// 収録していない合併(浦和市 → さいたま市)はそのまま
+		{"11201", time.Time{}, []string{"11201"}},
+		{"432017", time.Time{}, nil},
+		{"4320", time.Time{}, nil},
+	}
+	for _, tt := range tests {
+		if result := ResolveMunicipalityCode(tt.code, tt.at); !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("%s: result:%v expected:%v", tt.code, result, tt.expected)
+		}
+	}
+}
+
+func TestHasMunicipalityHistory(t *testing.T) {
+	if !HasMunicipalityHistory(10) {
+		t.Error("Gunma has no history.")
+	}
+	if HasMunicipalityHistory(11) || HasMunicipalityHistory(0) {
+		t.Error("Saitama has history.")
+	}
+	for _, c := range MunicipalityChanges() {
+		if c.Type != ChangeMerger && c.Type != ChangeAbsorption {
+			continue
+		}
+		if pref := c.Code[:2]; pref != "10" {
+			t.Errorf("%s: merger outside the covered prefectures.", c.Code)
+		}
+	}
+}
+
+func TestFormerMunicipalities(t *testing.T) {
+	list := FormerMunicipalities("22139")
+	var codes []string
+	for _, c := range list {
+		codes = append(codes, c.Code)
+	}
+	if !reflect.DeepEqual(codes, []string{"22135", "22136"}) {
+		t.Errorf("result is wrong. %v", codes)
+	}
+
+	if list := FormerMunicipalities("10202"); len(list) != 6 || list[5].Name != "吉井町" {
+		t.Errorf("result is wrong. %v", list)
+	}
+	if list := FormerMunicipalities("10207"); len(list) != 0 {
+		t.Errorf("result is wrong. %v", list)
+	}
+	if list := FormerMunicipalities("abc"); list != nil {
+		t.Errorf("result is wrong. %v", list)
+	}
+}
+
+func TestSameMunicipality(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"43201", "43100", true},
+		{"40305", "402311", true},
+		{"22135", "22139", true},
+		{"22136", "22138", false},
+		{"10361", "10202", true},
+		{"10361", "10209", false},
+		{"10202", "10201", false},
+		{"10202", "", false},
+	}
+	for _, tt := range tests {
+		if result := SameMunicipality(tt.a, tt.b); result != tt.expected {
+			t.Errorf("%s, %s: result:%v expected:%v", tt.a, tt.b, result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package address
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+総務省の全国地方公共団体コード(JIS X 0402 に準ずる)の市区町村の一覧です。
+
+政令指定都市の区と北方領土の村を含みます。
+internal/cmd/genmunicipality で総務省が公開する一覧から生成します。
+*/
+//go:embed data/municipalities.csv
+var municipalitiesCSV string
+
+var (
+	municipalities []Municipality
+	// 5 桁の団体コードから municipalities の位置への対応
+	municipalityIndex map[uint32]int
+)
+
+func init() {
+	r := csv.NewReader(strings.NewReader(municipalitiesCSV))
+	rows, err := r.ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("address: failed to read municipalities: %v", err))
+	}
+
+	municipalityIndex = make(map[uint32]int, len(rows))
+	// 1 行目は見出し
+	for _, row := range rows[1:] {
+		code, err := strconv.ParseUint(row[0][:5], 10, 32)
+		if err != nil {
+			panic(fmt.Sprintf("address: invalid municipality code: %s", row[0]))
+		}
+		m := Municipality{
+			PrefectureCode: uint8(code / 1000),
+			CityCode:       uint16(code % 1000),
+			Name:           row[1],
+			Kana:           row[2],
+			EnName:         row[3],
+		}
+		municipalityIndex[uint32(code)] = len(municipalities)
+		municipalities = append(municipalities, m)
+	}
+}
+
+// Municipality は市区町村です。
+type Municipality struct {
+	// 都道府県コード
+	PrefectureCode uint8
+	// 市区町村コード(団体コードの 3〜5 桁目)
+	CityCode uint16
+	// 名称(例: 高崎市, 政令指定都市の区は区名のみ)
+	Name string
+	// 読み(例: タカサキシ)
+	Kana string
+	// 英語表記(例: Takasaki-shi)
+	EnName string
+}
+
+// Code は検査数字を除いた 5 桁の団体コード(例: 10202)を返します。
+func (m Municipality) Code() string {
+	return fmt.Sprintf("%02d%03d", m.PrefectureCode, m.CityCode)
+}
+
+// LocalGovCode は検査数字を含む 6 桁の全国地方公共団体コード(例: 102024)を返します。
+func (m Municipality) LocalGovCode() string {
+	code := m.Code()
+	digit, _ := CheckDigit(code)
+	return code + strconv.Itoa(digit)
+}
+
+// Prefecture は市区町村が属する都道府県を返します。
+func (m Municipality) Prefecture() Prefecture {
+	p, _ := PrefectureByCode(m.PrefectureCode)
+	return p
+}
+
+// FullName は都道府県名を含む名称(例: 群馬県高崎市, 神奈川県横浜市中区)を返します。
+func (m Municipality) FullName() string {
+	name := m.Name
+	if parent, ok := m.Parent(); ok {
+		name = parent.Name + name
+	}
+	return m.Prefecture().Name + name
+}
+
+// IsDesignatedCity は政令指定都市か判定します。
+func (m Municipality) IsDesignatedCity() bool {
+	return m.CityCode >= 100 && m.CityCode < 200 && strings.HasSuffix(m.Name, "市")
+}
+
+// IsWard は政令指定都市の区か判定します。東京都の特別区は含みません。
+func (m Municipality) IsWard() bool {
+	return m.CityCode > 100 && m.CityCode < 200 && !m.IsSpecialWard() && strings.HasSuffix(m.Name, "区")
+}
+
+// IsSpecialWard は東京都の特別区か判定します。
+func (m Municipality) IsSpecialWard() bool {
+	return m.PrefectureCode == 13 && m.CityCode > 100 && m.CityCode < 200
+}
+
+/*
+Parent は政令指定都市の区が属する市を返します。
+
+政令指定都市の区のコードは市のコードに続けて割り当てられるため,
+同じ都道府県でコードが区以下の最も大きい政令指定都市を親とします。
+*/
+func (m Municipality) Parent() (Municipality, bool) {
+	if !m.IsWard() {
+		return Municipality{}, false
+	}
+	i := municipalityIndex[m.code()]
+	for i--; i >= 0; i-- {
+		p := municipalities[i]
+		if p.PrefectureCode != m.PrefectureCode || p.CityCode < 100 {
+			break
+		}
+		if p.IsDesignatedCity() {
+			return p, true
+		}
+	}
+	return Municipality{}, false
+}
+
+// Wards は政令指定都市の区をコードの順に返します。政令指定都市以外は空です。
+func (m Municipality) Wards() []Municipality {
+	if !m.IsDesignatedCity() {
+		return nil
+	}
+	var wards []Municipality
+	for _, w := range municipalities[municipalityIndex[m.code()]+1:] {
+		if !w.IsWard() || w.PrefectureCode != m.PrefectureCode {
+			break
+		}
+		wards = append(wards, w)
+	}
+	return wards
+}
+
+func (m Municipality) code() uint32 {
+	return uint32(m.PrefectureCode)*1000 + uint32(m.CityCode)
+}
+
+// Municipalities は市区町村の一覧を団体コードの順に返します。
+func Municipalities() []Municipality {
+	return append([]Municipality{}, municipalities...)
+}
+
+/*
+MunicipalityByCode は都道府県コードと市区町村コードから市区町村を返します。
+
+Corporation の PrefectureCode と CityCode をそのまま指定できます。
+*/
+func MunicipalityByCode(prefCode uint8, cityCode uint16) (Municipality, bool) {
+	if cityCode >= 1000 {
+		return Municipality{}, false
+	}
+	i, ok := municipalityIndex[uint32(prefCode)*1000+uint32(cityCode)]
+	if !ok {
+		return Municipality{}, false
+	}
+	return municipalities[i], true
+}
+
+/*
+MunicipalityByLocalGovCode は団体コードから市区町村を返します。
+
+検査数字を除いた 5 桁(例: 10202)と検査数字を含む 6 桁(例: 102024)に対応し,
+6 桁の場合は検査数字も検証します。
+*/
+func MunicipalityByLocalGovCode(code string) (Municipality, bool) {
+	code, ok := localGovCode(code)
+	if !ok {
+		return Municipality{}, false
+	}
+	n, _ := strconv.ParseUint(code, 10, 32)
+	return MunicipalityByCode(uint8(n/1000), uint16(n%1000))
+}
+
+// MunicipalitiesInPrefecture は都道府県に属する市区町村を団体コードの順に返します。
+func MunicipalitiesInPrefecture(prefCode uint8) []Municipality {
+	start := sort.Search(len(municipalities), func(i int) bool {
+		return municipalities[i].PrefectureCode >= prefCode
+	})
+	var list []Municipality
+	for _, m := range municipalities[start:] {
+		if m.PrefectureCode != prefCode {
+			break
+		}
+		list = append(list, m)
+	}
+	return list
+}
+
+/*
+MunicipalitiesByName は名称から市区町村を返します。
+
+「高崎市」のような名称は同名の市区町村をすべて返し,
+「群馬県高崎市」「横浜市中区」「神奈川県横浜市中区」のように都道府県名や市名を含む場合は絞り込んで返します。
+*/
+func MunicipalitiesByName(name string) []Municipality {
+	name = Normalize(name)
+	if name == "" {
+		return nil
+	}
+	var list []Municipality
+	for _, m := range municipalities {
+		if !strings.HasSuffix(name, m.Name) {
+			continue
+		}
+		full := m.FullName()
+		prefName := m.Prefecture().Name
+		if name == m.Name || name == full || name == strings.TrimPrefix(full, prefName) ||
+			name == shortName(prefName)+strings.TrimPrefix(full, prefName) {
+			list = append(list, m)
+		}
+	}
+	return list
+}
+
+/*
+CheckDigit は 5 桁の団体コードから全国地方公共団体コードの検査数字を計算します。
+
+各桁に 6, 5, 4, 3, 2 を掛けた和を 11 で割った余りを 11 から引き, その 1 の位を検査数字とします。
+*/
+func CheckDigit(code string) (int, error) {
+	if len(code) != 5 || !isDigits(code) {
+		return 0, fmt.Errorf("local government code must be 5 digits: %q", code)
+	}
+	sum := 0
+	for i, r := range code {
+		sum += int(r-'0') * (6 - i)
+	}
+	return (11 - sum%11) % 10, nil
+}
+
+// ValidLocalGovCode は 6 桁の全国地方公共団体コードの検査数字が正しいか判定します。
+func ValidLocalGovCode(code string) bool {
+	if len(code) != 6 || !isDigits(code) {
+		return false
+	}
+	digit, err := CheckDigit(code[:5])
+	return err == nil && int(code[5]-'0') == digit
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !isDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,189 @@
+package address
+
+import "testing"
+
+func TestMunicipalities(t *testing.T) {
+	list := Municipalities()
+	if len(list) == 0 {
+		t.Fatal("list is empty.")
+	}
+
+	designated := 0
+	seen := map[string]bool{}
+	for _, m := range list {
+		if seen[m.Code()] {
+			t.Errorf("%s: code is duplicated.", m.Code())
+		}
+		seen[m.Code()] = true
+
+		if !IsPrefectureCode(m.PrefectureCode) {
+			t.Errorf("%s: prefecture code is wrong.", m.Code())
+		}
+		if m.Name == "" || m.Kana == "" || m.EnName == "" {
+			t.Errorf("%s: field is empty. %+v", m.Code(), m)
+		}
+		if !ValidLocalGovCode(m.LocalGovCode()) {
+			t.Errorf("%s: check digit is wrong.", m.LocalGovCode())
+		}
+		if m.IsWard() {
+			if _, ok := m.Parent(); !ok {
+				t.Errorf("%s: parent is not found.", m.Name)
+			}
+		}
+		if m.IsDesignatedCity() {
+			designated++
+			if len(m.Wards()) == 0 {
+				t.Errorf("%s: wards are not found.", m.Name)
+			}
+		}
+	}
+	if designated != 20 {
+		t.Errorf("designated city count is wrong. result:%d", designated)
+	}
+
+	list[0].Name = "changed"
+	if m, _ := MunicipalityByCode(1, 100); m.Name != "札幌市" {
+		t.Error("table is changed.")
+	}
+}
+
+func TestMunicipalityByCode(t *testing.T) {
+	m, ok := MunicipalityByCode(10, 202)
+	if !ok {
+		t.Fatal("10202 is not found.")
+	}
+	expected := Municipality{PrefectureCode: 10, CityCode: 202, Name: "高崎市", Kana: "タカサキシ", EnName: "Takasaki-shi"}
+	if m != expected {
+		t.Errorf("result is wrong. result:%+v", m)
+	}
+	if m.Code() != "10202" || m.LocalGovCode() != "102024" || m.FullName() != "群馬県高崎市" {
+		t.Errorf("code or name is wrong. %s %s %s", m.Code(), m.LocalGovCode(), m.FullName())
+	}
+	if m.Prefecture().Name != "群馬県" || m.IsWard() || m.IsDesignatedCity() || m.IsSpecialWard() {
+		t.Errorf("attribute is wrong. %+v", m)
+	}
+
+	for _, code := range [][2]int{{13, 999}, {0, 202}, {48, 201}, {10, 1202}} {
+		if _, ok := MunicipalityByCode(uint8(code[0]), uint16(code[1])); ok {
+			t.Errorf("%v is found.", code)
+		}
+	}
+}
+
+func TestMunicipalityWard(t *testing.T) {
+	naka, ok := MunicipalityByLocalGovCode("14104")
+	if !ok {
+		t.Fatal("14104 is not found.")
+	}
+	if !naka.IsWard() || naka.FullName() != "神奈川県横浜市中区" {
+		t.Errorf("ward is wrong. %+v %s", naka, naka.FullName())
+	}
+	yokohama, ok := naka.Parent()
+	if !ok || yokohama.Name != "横浜市" || !yokohama.IsDesignatedCity() {
+		t.Errorf("parent is wrong. %+v", yokohama)
+	}
+	if wards := yokohama.Wards(); len(wards) != 18 || wards[0].Name != "鶴見区" {
+		t.Errorf("wards are wrong. %d", len(wards))
+	}
+
+	// 川崎市は横浜市の区の後に続く
+	kawasaki, _ := MunicipalityByCode(14, 131)
+	if p, _ := kawasaki.Parent(); p.Name != "川崎市" {
+		t.Errorf("parent is wrong. %+v", p)
+	}
+
+	chiyoda, _ := MunicipalityByCode(13, 101)
+	if !chiyoda.IsSpecialWard() || chiyoda.IsWard() {
+		t.Errorf("special ward is wrong. %+v", chiyoda)
+	}
+	if _, ok := chiyoda.Parent(); ok {
+		t.Error("special ward has parent.")
+	}
+	if len(chiyoda.Wards()) != 0 {
+		t.Error("special ward has wards.")
+	}
+}
+
+func TestMunicipalityByLocalGovCode(t *testing.T) {
+	tests := map[string]string{
+		"102024": "高崎市",
+		"10202":  "高崎市",
+		"131016": "千代田区",
+		"102025": "",
+		"13999":  "",
+		"1020":   "",
+		"1020a":  "",
+		"":       "",
+	}
+	for code, expected := range tests {
+		m, ok := MunicipalityByLocalGovCode(code)
+		if ok != (expected != "") || m.Name != expected {
+			t.Errorf("%s: result:%s expected:%s", code, m.Name, expected)
+		}
+	}
+}
+
+func TestMunicipalitiesInPrefecture(t *testing.T) {
+	list := MunicipalitiesInPrefecture(10)
+	if len(list) != 35 {
+		t.Errorf("length is wrong. result:%d", len(list))
+	}
+	for _, m := range list {
+		if m.PrefectureCode != 10 {
+			t.Errorf("%s: prefecture code is wrong.", m.Name)
+		}
+	}
+	if list := MunicipalitiesInPrefecture(48); len(list) != 0 {
+		t.Errorf("length is wrong. result:%d", len(list))
+	}
+}
+
+func TestMunicipalitiesByName(t *testing.T) {
+	tests := map[string][]string{
+		"高崎市":       {"10202"},
+		"群馬県高崎市":    {"10202"},
+		"群馬高崎市":     {"10202"},
+		"横浜市中区":     {"14104"},
+		"神奈川県横浜市中区": {"14104"},
+		"静岡県高崎市":    nil,
+		"":          nil,
+	}
+	for name, expected := range tests {
+		list := MunicipalitiesByName(name)
+		if len(list) != len(expected) {
+			t.Errorf("%s: length is wrong. result:%d expected:%d", name, len(list), len(expected))
+			continue
+		}
+		for i, m := range list {
+			if m.Code() != expected[i] {
+				t.Errorf("%s: result:%s expected:%s", name, m.Code(), expected[i])
+			}
+		}
+	}
+
+	// 同名の区はすべて返す
+	if list := MunicipalitiesByName("中区"); len(list) < 2 {
+		t.Errorf("length is wrong. result:%d", len(list))
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	tests := map[string]int{
+		"10202": 4,
+		"13101": 6,
+		"01100": 2,
+		"14100": 3,
+	}
+	for code, expected := range tests {
+		digit, err := CheckDigit(code)
+		if err != nil || digit != expected {
+			t.Errorf("%s: result:%d expected:%d err:%v", code, digit, expected, err)
+		}
+	}
+
+	for _, code := range []string{"", "1020", "102024", "1020a"} {
+		if _, err := CheckDigit(code); err == nil {
+			t.Errorf("%s: error is nil.", code)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package address
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// 漢数字
+const kanjiDigits = "〇一二三四五六七八九"
+
+var (
+	// 漢数字の後に続く住所の単位
+	rKanjiBefore = regexp.MustCompile(`([〇一二三四五六七八九十百千]+)(丁目|番地|番|号|地割)`)
+	// 漢数字の前にある住所の単位
+	rKanjiAfter = regexp.MustCompile(`(丁目|番地|番|号|-)([〇一二三四五六七八九十百千]+)`)
+	// rKanjiAfter の漢数字の後に続く住所の単位または区切り
+	rKanjiAfterEnd = regexp.MustCompile(`^(丁目|番地|番|号|地割|-|の|\s|$)`)
+	// 連続する空白
+	rSpaces = regexp.MustCompile(`\s+`)
+)
+
+/*
+Normalize は住所の表記ゆれを正規化します。
+
+・全角の英数字と記号を半角に変換します(例: １４７番地４ → 147番地4)
+
+・半角カタカナを全角に変換します(例: ｳｴｽﾄ → ウエスト)
+
+・ハイフンに類する記号と数字に続く長音記号を半角のハイフンに変換します
+
+・丁目, 番地, 番, 号の前後にある漢数字を算用数字に変換します(例: 五十八番地 → 58番地)
+
+・連続する空白を 1 つの半角空白にまとめ, 前後の空白を取り除きます
+
+「一番町」のように町名の一部である漢数字は変換しません。
+丁目, 番地, 番, 号の後の漢数字は, さらに単位・区切りが続くか末尾の場合のみ変換し,
+「1号六本木ヒルズ」のように建物名等の一部である漢数字は変換しません。
+*/
+func Normalize(s string) string {
+	s = normalize.Width(s)
+	s = normalizeHyphen(s)
+	s = replaceKanjiNumerals(s)
+	return strings.TrimSpace(rSpaces.ReplaceAllString(s, " "))
+}
+
+// normalizeHyphen はハイフンに類する記号を半角のハイフンに変換します。
+// 長音記号は前後が数字の場合のみ変換します。
+func normalizeHyphen(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		switch r {
+		case '‐', '‑', '‒', '–', '—', '―', '−', '─', '━':
+			runes[i] = '-'
+		case 'ー':
+			if (i > 0 && isDigit(runes[i-1])) || (i+1 < len(runes) && isDigit(runes[i+1])) {
+				runes[i] = '-'
+			}
+		}
+	}
+	return string(runes)
+}
+
+// replaceKanjiNumerals は住所の単位の前後にある漢数字を算用数字に変換します。
+func replaceKanjiNumerals(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range rKanjiBefore.FindAllStringSubmatchIndex(s, -1) {
+		// 一番町, 二番町など「番町」は町名の一部
+		if s[m[4]:m[5]] == "番" && strings.HasPrefix(s[m[5]:], "町") {
+			continue
+		}
+		b.WriteString(s[last:m[2]])
+		b.WriteString(kanjiToNumber(s[m[2]:m[3]]))
+		last = m[3]
+	}
+	b.WriteString(s[last:])
+	s = b.String()
+
+	// 単位の後の漢数字は, 続けて単位・区切りがあるか末尾の場合のみ変換する
+	// (例: 10番1号六本木ヒルズ の「六」は建物名の一部)
+	b.Reset()
+	last = 0
+	for _, m := range rKanjiAfter.FindAllStringSubmatchIndex(s, -1) {
+		if !rKanjiAfterEnd.MatchString(s[m[1]:]) {
+			continue
+		}
+		b.WriteString(s[last:m[4]])
+		b.WriteString(kanjiToNumber(s[m[4]:m[5]]))
+		last = m[5]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+/*
+kanjiToNumber は漢数字を算用数字の文字列に変換します。
+
+「五十八」のような位取りの表記と「二〇三」のような 1 桁ずつの表記に対応します。
+*/
+func kanjiToNumber(s string) string {
+	runes := []rune(s)
+	if !strings.ContainsAny(s, "十百千") {
+		out := make([]rune, len(runes))
+		for i, r := range runes {
+			out[i] = '0' + rune(strings.IndexRune(kanjiDigits, r)/len("〇"))
+		}
+		return string(out)
+	}
+
+	total, digit := 0, -1
+	for _, r := range runes {
+		unit := 0
+		switch r {
+		case '十':
+			unit = 10
+		case '百':
+			unit = 100
+		case '千':
+			unit = 1000
+		default:
+			digit = strings.IndexRune(kanjiDigits, r) / len("〇")
+			continue
+		}
+		if digit == -1 {
+			digit = 1
+		}
+		total += digit * unit
+		digit = -1
+	}
+	if digit != -1 {
+		total += digit
+	}
+	return strconv.Itoa(total)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
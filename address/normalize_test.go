@@ -0,0 +1,45 @@
+package address
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"full width":        {"飯塚町１４７番地４", "飯塚町147番地4"},
+		"building":          {"八島町５８番地１ウエストワンビル１０Ｆ１０１１号", "八島町58番地1ウエストワンビル10F1011号"},
+		"half width kana":   {"ｳｴｽﾄﾜﾝﾋﾞﾙ ﾊﾟｰｸ", "ウエストワンビル パーク"},
+		"hyphen":            {"八島町５８－１", "八島町58-1"},
+		"long vowel":        {"八島町58ー1 ビーム", "八島町58-1 ビーム"},
+		"kanji numerals":    {"大手町一丁目一番一号", "大手町1丁目1番1号"},
+		"positional":        {"八島町五十八番地一", "八島町58番地1"},
+		"digit by digit":    {"本町二〇三番地", "本町203番地"},
+		"hundreds":          {"本町百二十番地", "本町120番地"},
+		"town name":         {"千代田区一番町", "千代田区一番町"},
+		"town with kanji":   {"三条通四丁目", "三条通4丁目"},
+		"building kanji":    {"六本木六丁目十番一号六本木ヒルズ森タワー", "六本木6丁目10番1号六本木ヒルズ森タワー"},
+		"after unit":        {"八島町五十八番地一 ウエストワンビル", "八島町58番地1 ウエストワンビル"},
+		"spaces":            {"　八島町  58番地 ", "八島町 58番地"},
+		"ideographic space": {"本町　１丁目", "本町 1丁目"},
+	}
+
+	for name, tt := range tests {
+		if result := Normalize(tt.input); result != tt.expected {
+			t.Errorf("%s: result:%s expected:%s", name, result, tt.expected)
+		}
+	}
+}
+
+func TestNormalizePostCode(t *testing.T) {
+	for input, expected := range map[string]string{
+		"〒３７０−００６９": "3700069",
+		"370-0069":  "3700069",
+		"3700069":   "3700069",
+		"":          "",
+	} {
+		if result := NormalizePostCode(input); result != expected {
+			t.Errorf("result:%s expected:%s", result, expected)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package address
+
+import "strings"
+
+// OverseasCode は国外所在地を表す都道府県コードです。法人番号システム Web-API の所在地の指定で使用します。
+const OverseasCode = 99
+
+// Region は都道府県の地方区分です。
+type Region uint8
+
+const (
+	RegionHokkaido Region = iota + 1
+	RegionTohoku
+	RegionKanto
+	RegionChubu
+	RegionKinki
+	RegionChugoku
+	RegionShikoku
+	RegionKyushu
+)
+
+var regionNames = map[Region][2]string{
+	RegionHokkaido: {"北海道", "Hokkaido"},
+	RegionTohoku:   {"東北", "Tohoku"},
+	RegionKanto:    {"関東", "Kanto"},
+	RegionChubu:    {"中部", "Chubu"},
+	RegionKinki:    {"近畿", "Kinki"},
+	RegionChugoku:  {"中国", "Chugoku"},
+	RegionShikoku:  {"四国", "Shikoku"},
+	RegionKyushu:   {"九州・沖縄", "Kyushu-Okinawa"},
+}
+
+// String は地方区分の名称を返します。
+func (r Region) String() string {
+	return regionNames[r][0]
+}
+
+// EnName は地方区分の英語表記を返します。
+func (r Region) EnName() string {
+	return regionNames[r][1]
+}
+
+// Prefecture は都道府県です。
+type Prefecture struct {
+	// 都道府県コード
+	// JIS X 0401 に準ずる
+	Code uint8
+	// 名称(例: 群馬県)
+	Name string
+	// 読み(例: グンマケン)
+	Kana string
+	// 英語表記(例: Gunma)
+	EnName string
+	// 地方区分
+	Region Region
+}
+
+// 都道府県の一覧(JIS X 0401 の順)
+var prefectures = []Prefecture{
+	{1, "北海道", "ホッカイドウ", "Hokkaido", RegionHokkaido},
+	{2, "青森県", "アオモリケン", "Aomori", RegionTohoku},
+	{3, "岩手県", "イワテケン", "Iwate", RegionTohoku},
+	{4, "宮城県", "ミヤギケン", "Miyagi", RegionTohoku},
+	{5, "秋田県", "アキタケン", "Akita", RegionTohoku},
+	{6, "山形県", "ヤマガタケン", "Yamagata", RegionTohoku},
+	{7, "福島県", "フクシマケン", "Fukushima", RegionTohoku},
+	{8, "茨城県", "イバラキケン", "Ibaraki", RegionKanto},
+	{9, "栃木県", "トチギケン", "Tochigi", RegionKanto},
+	{10, "群馬県", "グンマケン", "Gunma", RegionKanto},
+	{11, "埼玉県", "サイタマケン", "Saitama", RegionKanto},
+	{12, "千葉県", "チバケン", "Chiba", RegionKanto},
+	{13, "東京都", "トウキョウト", "Tokyo", RegionKanto},
+	{14, "神奈川県", "カナガワケン", "Kanagawa", RegionKanto},
+	{15, "新潟県", "ニイガタケン", "Niigata", RegionChubu},
+	{16, "富山県", "トヤマケン", "Toyama", RegionChubu},
+	{17, "石川県", "イシカワケン", "Ishikawa", RegionChubu},
+	{18, "福井県", "フクイケン", "Fukui", RegionChubu},
+	{19, "山梨県", "ヤマナシケン", "Yamanashi", RegionChubu},
+	{20, "長野県", "ナガノケン", "Nagano", RegionChubu},
+	{21, "岐阜県", "ギフケン", "Gifu", RegionChubu},
+	{22, "静岡県", "シズオカケン", "Shizuoka", RegionChubu},
+	{23, "愛知県", "アイチケン", "Aichi", RegionChubu},
+	{24, "三重県", "ミエケン", "Mie", RegionKinki},
+	{25, "滋賀県", "シガケン", "Shiga", RegionKinki},
+	{26, "京都府", "キョウトフ", "Kyoto", RegionKinki},
+	{27, "大阪府", "オオサカフ", "Osaka", RegionKinki},
+	{28, "兵庫県", "ヒョウゴケン", "Hyogo", RegionKinki},
+	{29, "奈良県", "ナラケン", "Nara", RegionKinki},
+	{30, "和歌山県", "ワカヤマケン", "Wakayama", RegionKinki},
+	{31, "鳥取県", "トットリケン", "Tottori", RegionChugoku},
+	{32, "島根県", "シマネケン", "Shimane", RegionChugoku},
+	{33, "岡山県", "オカヤマケン", "Okayama", RegionChugoku},
+	{34, "広島県", "ヒロシマケン", "Hiroshima", RegionChugoku},
+	{35, "山口県", "ヤマグチケン", "Yamaguchi", RegionChugoku},
+	{36, "徳島県", "トクシマケン", "Tokushima", RegionShikoku},
+	{37, "香川県", "カガワケン", "Kagawa", RegionShikoku},
+	{38, "愛媛県", "エヒメケン", "Ehime", RegionShikoku},
+	{39, "高知県", "コウチケン", "Kochi", RegionShikoku},
+	{40, "福岡県", "フクオカケン", "Fukuoka", RegionKyushu},
+	{41, "佐賀県", "サガケン", "Saga", RegionKyushu},
+	{42, "長崎県", "ナガサキケン", "Nagasaki", RegionKyushu},
+	{43, "熊本県", "クマモトケン", "Kumamoto", RegionKyushu},
+	{44, "大分県", "オオイタケン", "Oita", RegionKyushu},
+	{45, "宮崎県", "ミヤザキケン", "Miyazaki", RegionKyushu},
+	{46, "鹿児島県", "カゴシマケン", "Kagoshima", RegionKyushu},
+	{47, "沖縄県", "オキナワケン", "Okinawa", RegionKyushu},
+}
+
+// Prefectures は都道府県の一覧を都道府県コードの順に返します。
+func Prefectures() []Prefecture {
+	return append([]Prefecture{}, prefectures...)
+}
+
+// PrefectureByCode は都道府県コードから都道府県を返します。
+func PrefectureByCode(code uint8) (Prefecture, bool) {
+	if code < 1 || int(code) > len(prefectures) {
+		return Prefecture{}, false
+	}
+	return prefectures[code-1], true
+}
+
+/*
+PrefectureByName は名称から都道府県を返します。
+
+「群馬県」と「群馬」のように都・府・県を省略した名称, 英語表記(大文字と小文字は区別しません)に対応します。
+*/
+func PrefectureByName(name string) (Prefecture, bool) {
+	name = Normalize(name)
+	for _, p := range prefectures {
+		if name == p.Name || name == shortName(p.Name) || strings.EqualFold(name, p.EnName) {
+			return p, true
+		}
+	}
+	return Prefecture{}, false
+}
+
+// PrefecturesInRegion は地方区分に属する都道府県を都道府県コードの順に返します。
+func PrefecturesInRegion(r Region) []Prefecture {
+	var list []Prefecture
+	for _, p := range prefectures {
+		if p.Region == r {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// IsPrefectureCode は都道府県コードとして有効か判定します。
+func IsPrefectureCode(code uint8) bool {
+	_, ok := PrefectureByCode(code)
+	return ok
+}
+
+// shortName は都・府・県を除いた名称を返します。北海道はそのままです。
+func shortName(name string) string {
+	if name == "北海道" {
+		return name
+	}
+	for _, suffix := range []string{"都", "府", "県"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return name
+}
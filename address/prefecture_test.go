@@ -0,0 +1,68 @@
+package address
+
+import "testing"
+
+func TestPrefectures(t *testing.T) {
+	list := Prefectures()
+	if len(list) != 47 {
+		t.Fatalf("length is wrong. result:%d", len(list))
+	}
+	for i, p := range list {
+		if int(p.Code) != i+1 {
+			t.Errorf("%s: code is wrong. result:%d expected:%d", p.Name, p.Code, i+1)
+		}
+		if p.Kana == "" || p.EnName == "" || p.Region.String() == "" {
+			t.Errorf("%s: field is empty. %+v", p.Name, p)
+		}
+	}
+
+	list[0].Name = "changed"
+	if p, _ := PrefectureByCode(1); p.Name != "北海道" {
+		t.Error("table is changed.")
+	}
+}
+
+func TestPrefectureByCode(t *testing.T) {
+	p, ok := PrefectureByCode(10)
+	if !ok || p.Name != "群馬県" || p.Kana != "グンマケン" || p.EnName != "Gunma" || p.Region != RegionKanto {
+		t.Errorf("result is wrong. %+v", p)
+	}
+
+	for _, code := range []uint8{0, 48, OverseasCode} {
+		if _, ok := PrefectureByCode(code); ok {
+			t.Errorf("%d is found.", code)
+		}
+	}
+}
+
+func TestPrefectureByName(t *testing.T) {
+	tests := map[string]uint8{
+		"群馬県":     10,
+		"群馬":      10,
+		"北海道":     1,
+		"京都府":     26,
+		"京都":      26,
+		"東京":      13,
+		"okinawa": 47,
+		" 大阪府":    27,
+		"群馬県高崎市":  0,
+		"":        0,
+	}
+
+	for name, expected := range tests {
+		p, ok := PrefectureByName(name)
+		if ok != (expected != 0) || p.Code != expected {
+			t.Errorf("%s: result:%d expected:%d", name, p.Code, expected)
+		}
+	}
+}
+
+func TestPrefecturesInRegion(t *testing.T) {
+	list := PrefecturesInRegion(RegionShikoku)
+	if len(list) != 4 || list[0].Name != "徳島県" || list[3].Name != "高知県" {
+		t.Errorf("result is wrong. %+v", list)
+	}
+	if RegionKyushu.String() != "九州・沖縄" || RegionKyushu.EnName() != "Kyushu-Okinawa" {
+		t.Error("region name is wrong.")
+	}
+}
@@ -0,0 +1,109 @@
+package address
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// 町名の後に続く番地等の開始位置
+	rStreetStart = regexp.MustCompile(`\d+(丁目|番地|番|号|-|の|$)`)
+	// 丁目-番地-号 の形式
+	rHyphenated = regexp.MustCompile(`^(\d+)-(\d+)-(\d+)`)
+	// 丁目, 番地, 号の形式
+	rStreet = regexp.MustCompile(`^(?:(\d+)丁目-?)?(?:(\d+)(?:番地の?|番|の|-)?)?(?:(\d+)(?:号|番地?)?)?`)
+)
+
+/*
+Street は丁目番地等(StreetNumber)を町名, 丁目, 番地, 号, 建物名等に分割した結果です。
+
+数字は正規化されて算用数字になります。該当する部分がない場合は空文字です。
+*/
+type Street struct {
+	// 町名(例: 八島町)
+	Town string
+	// 丁目
+	Chome string
+	// 番地
+	Banchi string
+	// 号
+	Go string
+	// 建物名・部屋番号等(例: ウエストワンビル10F1011号)
+	Building string
+}
+
+/*
+ParseStreet は丁目番地等を正規化し, 町名, 丁目, 番地, 号, 建物名等に分割します。
+
+	ParseStreet("八島町５８番地１ウエストワンビル１０Ｆ１０１１号")
+	// Street{Town: "八島町", Banchi: "58", Go: "1", Building: "ウエストワンビル10F1011号"}
+
+「1-2-3」のように数字が 3 つハイフンで区切られている場合は丁目, 番地, 号とみなし,
+「58-1」のように 2 つの場合は番地, 号とみなします。
+番地等を判別できない場合は全体を町名とします。
+*/
+func ParseStreet(s string) Street {
+	s = Normalize(s)
+
+	loc := rStreetStart.FindStringIndex(s)
+	if loc == nil {
+		return Street{Town: s}
+	}
+
+	street := Street{Town: strings.TrimSpace(s[:loc[0]])}
+	rest := s[loc[0]:]
+
+	if m := rHyphenated.FindStringSubmatch(rest); m != nil {
+		street.Chome, street.Banchi, street.Go = m[1], m[2], m[3]
+		rest = rest[len(m[0]):]
+	} else {
+		m := rStreet.FindStringSubmatch(rest)
+		street.Chome, street.Banchi, street.Go = m[1], m[2], m[3]
+		rest = rest[len(m[0]):]
+	}
+
+	street.Building = strings.TrimSpace(strings.TrimLeft(rest, "-の "))
+	return street
+}
+
+// Number は丁目, 番地, 号をハイフンでつないだ文字列を返します(例: 1-58-1)。
+func (s Street) Number() string {
+	var parts []string
+	for _, p := range []string{s.Chome, s.Banchi, s.Go} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+/*
+String は正規化した丁目番地等を返します。
+
+丁目がある場合は住居表示として「番」「号」を, ない場合は「番地」を用います。
+
+	八島町58番地1 ウエストワンビル10F1011号
+	大手町1丁目1番1号
+*/
+func (s Street) String() string {
+	var b strings.Builder
+	b.WriteString(s.Town)
+	if s.Chome != "" {
+		b.WriteString(s.Chome + "丁目")
+		if s.Banchi != "" {
+			b.WriteString(s.Banchi + "番")
+		}
+		if s.Go != "" {
+			b.WriteString(s.Go + "号")
+		}
+	} else {
+		if s.Banchi != "" {
+			b.WriteString(s.Banchi + "番地")
+		}
+		b.WriteString(s.Go)
+	}
+	if s.Building != "" {
+		b.WriteString(" " + s.Building)
+	}
+	return b.String()
+}
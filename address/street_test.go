@@ -0,0 +1,49 @@
+package address
+
+import "testing"
+
+func TestParseStreet(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected Street
+	}{
+		"banchi and go":  {"飯塚町１４７番地４", Street{Town: "飯塚町", Banchi: "147", Go: "4"}},
+		"with building":  {"八島町５８番地１ウエストワンビル１０Ｆ１０１１号", Street{Town: "八島町", Banchi: "58", Go: "1", Building: "ウエストワンビル10F1011号"}},
+		"residence":      {"大手町１丁目１番１号", Street{Town: "大手町", Chome: "1", Banchi: "1", Go: "1"}},
+		"kanji numerals": {"大手町一丁目一番一号 大手ビル", Street{Town: "大手町", Chome: "1", Banchi: "1", Go: "1", Building: "大手ビル"}},
+		"hyphenated":     {"大手町1-1-1", Street{Town: "大手町", Chome: "1", Banchi: "1", Go: "1"}},
+		"two numbers":    {"八島町58-1 ウエストワンビル", Street{Town: "八島町", Banchi: "58", Go: "1", Building: "ウエストワンビル"}},
+		"banchi only":    {"本町４８番地", Street{Town: "本町", Banchi: "48"}},
+		"no":             {"八島町58番地の1", Street{Town: "八島町", Banchi: "58", Go: "1"}},
+		"town digits":    {"北１条西２丁目３番", Street{Town: "北1条西", Chome: "2", Banchi: "3"}},
+		"building kanji": {"六本木6丁目10番1号六本木ヒルズ森タワー", Street{Town: "六本木", Chome: "6", Banchi: "10", Go: "1", Building: "六本木ヒルズ森タワー"}},
+		"town only":      {"一番町", Street{Town: "一番町"}},
+	}
+
+	for name, tt := range tests {
+		if result := ParseStreet(tt.input); result != tt.expected {
+			t.Errorf("%s: result:%+v expected:%+v", name, result, tt.expected)
+		}
+	}
+}
+
+func TestStreetString(t *testing.T) {
+	tests := map[string]struct {
+		input    Street
+		expected string
+		number   string
+	}{
+		"banchi":    {Street{Town: "八島町", Banchi: "58", Go: "1", Building: "ウエストワンビル"}, "八島町58番地1 ウエストワンビル", "58-1"},
+		"residence": {Street{Town: "大手町", Chome: "1", Banchi: "1", Go: "1"}, "大手町1丁目1番1号", "1-1-1"},
+		"town":      {Street{Town: "一番町"}, "一番町", ""},
+	}
+
+	for name, tt := range tests {
+		if result := tt.input.String(); result != tt.expected {
+			t.Errorf("%s: result:%s expected:%s", name, result, tt.expected)
+		}
+		if result := tt.input.Number(); result != tt.number {
+			t.Errorf("%s: number result:%s expected:%s", name, result, tt.number)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package corp
+
+import (
+	"fmt"
+	"time"
+)
+
+// EntityStatus は指定日時点の法人の状態です。
+type EntityStatus int
+
+const (
+	// 法人番号の指定前または変更履歴がなく状態が不明
+	StatusUnknown EntityStatus = iota
+	// 存続
+	StatusActive
+	// 登記記録の閉鎖等
+	StatusClosed
+)
+
+func (s EntityStatus) String() string {
+	switch s {
+	case StatusActive:
+		return "active"
+	case StatusClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// Snapshot は指定日時点で有効だった法人の登記内容です。
+type Snapshot struct {
+	// 基準日
+	Date time.Time
+	// 基準日時点の法人情報
+	// Status が StatusUnknown の場合はゼロ値
+	State Corporation
+	// 基準日時点の状態
+	Status EntityStatus
+}
+
+// Existed は基準日時点で法人が存在していたか判定します。閉鎖済みの場合も true を返します。
+func (s Snapshot) Existed() bool {
+	return s.Status != StatusUnknown
+}
+
+// Closed は基準日時点で登記記録が閉鎖されていたか判定します。
+func (s Snapshot) Closed() bool {
+	return s.Status == StatusClosed
+}
+
+/*
+AsOf は date 時点で有効だった法人の登記内容を返します。
+
+各状態は変更年月日(ChangeDate)から有効とみなします。
+登記記録の閉鎖等(21)と吸収合併(71)は閉鎖等年月日(CloseDate)がより早い場合はその日から有効とみなします。
+訂正のレコードは Timeline 上で訂正対象の状態に反映済みのため, 訂正後の内容が返されます。
+
+date が法人番号指定年月日(AssignmentDate)より前の場合は StatusUnknown を返します。
+*/
+func (t Timeline) AsOf(date time.Time) Snapshot {
+	date = truncateDate(date)
+	snapshot := Snapshot{Date: date}
+
+	if len(t.Entries) == 0 {
+		return snapshot
+	}
+
+	first := t.Entries[0].State
+	if !isZeroDate(first.AssignmentDate) && date.Before(first.AssignmentDate.Time()) {
+		return snapshot
+	}
+
+	idx := -1
+	for i, e := range t.Entries {
+		effective := effectiveDate(e.State)
+		if effective.IsZero() || !effective.After(date) {
+			idx = i
+		}
+	}
+	// 指定年月日以降で変更年月日より前の場合は最初の状態とみなす
+	if idx == -1 {
+		idx = 0
+	}
+
+	state := t.Entries[idx].State
+	snapshot.State = state
+	snapshot.Status = StatusActive
+	if !isZeroDate(state.CloseDate) && !state.CloseDate.Time().After(date) {
+		snapshot.Status = StatusClosed
+	}
+	return snapshot
+}
+
+/*
+AsOf は法人番号の変更履歴を取得し, date 時点で有効だった法人の登記内容を返します。
+
+法人情報が存在しない場合はエラーを返します。
+*/
+func AsOf(number uint64, date time.Time) (Snapshot, error) {
+	res, err := ByNumberWithHistory(number)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	for _, t := range Timelines(res) {
+		if t.CorporateNumber == number {
+			return t.AsOf(date), nil
+		}
+	}
+	return Snapshot{}, fmt.Errorf("corporation not found: %d", number)
+}
+
+// effectiveDate は状態が有効になった日付を返します。不明な場合はゼロ値を返します。
+func effectiveDate(c Corporation) time.Time {
+	var effective time.Time
+	if !isZeroDate(c.ChangeDate) {
+		effective = c.ChangeDate.Time()
+	}
+
+	if (c.Process == ProcessClosed || c.Process == ProcessAbsorbed) && !isZeroDate(c.CloseDate) {
+		closed := c.CloseDate.Time()
+		if effective.IsZero() || closed.Before(effective) {
+			effective = closed
+		}
+	}
+	return effective
+}
+
+func isZeroDate(d *Date) bool {
+	return d == nil || d.Time().IsZero()
+}
@@ -0,0 +1,82 @@
+package corp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimelineAsOf(t *testing.T) {
+	tl := Timelines(testResponse(t, "./testdata/response/by_number_with_history.xml"))[0]
+
+	tests := []struct {
+		date         time.Time
+		status       EntityStatus
+		streetNumber string
+	}{
+		{time.Date(2016, 9, 4, 0, 0, 0, 0, currentLocation()), StatusUnknown, ""},
+		{time.Date(2016, 9, 5, 0, 0, 0, 0, currentLocation()), StatusActive, "八島町５８番地１ウエストワンビル１０Ｆ１０１１号"},
+		{time.Date(2018, 5, 1, 23, 59, 0, 0, currentLocation()), StatusActive, "八島町５８番地１ウエストワンビル１０Ｆ１０１１号"},
+		{time.Date(2018, 5, 2, 0, 0, 0, 0, currentLocation()), StatusActive, "本町４８番地"},
+		{time.Date(2021, 6, 2, 0, 0, 0, 0, currentLocation()), StatusActive, "飯塚町１４７番地４"},
+		{time.Date(2030, 1, 1, 0, 0, 0, 0, currentLocation()), StatusActive, "飯塚町１４７番地４"},
+	}
+
+	for i, test := range tests {
+		s := tl.AsOf(test.date)
+		if s.Status != test.status {
+			t.Errorf("%d: Status is wrong. result:%s expected:%s", i, s.Status, test.status)
+		}
+
+		if s.State.StreetNumber != test.streetNumber {
+			t.Errorf("%d: StreetNumber is wrong. result:%s expected:%s", i, s.State.StreetNumber, test.streetNumber)
+		}
+	}
+}
+
+func TestTimelineAsOfClosed(t *testing.T) {
+	d := func(year int, month time.Month, day int) *Date {
+		date := Date(time.Date(year, month, day, 0, 0, 0, 0, currentLocation()))
+		return &date
+	}
+
+	tl := NewTimeline([]Corporation{
+		{SequenceNumber: 1, CorporateNumber: testFillinCorpNum, Process: "01", ChangeDate: d(2016, 9, 5), AssignmentDate: d(2016, 9, 5), Name: "株式会社フィルイン"},
+		// 閉鎖等年月日より後に登録された吸収合併
+		{SequenceNumber: 2, CorporateNumber: testFillinCorpNum, Process: "71", ChangeDate: d(2022, 4, 10), CloseDate: d(2022, 4, 1), CloseCause: "11", AssignmentDate: d(2016, 9, 5), Name: "株式会社フィルイン"},
+	})
+
+	s := tl.AsOf(time.Date(2022, 3, 31, 0, 0, 0, 0, currentLocation()))
+	if s.Closed() || !s.Existed() {
+		t.Errorf("status before close date is wrong. result:%s", s.Status)
+	}
+
+	s = tl.AsOf(time.Date(2022, 4, 1, 0, 0, 0, 0, currentLocation()))
+	if !s.Closed() || !s.Existed() {
+		t.Errorf("status on close date is wrong. result:%s", s.Status)
+	}
+
+	if s.State.CloseCause != "11" {
+		t.Errorf("CloseCause is wrong. result:%s expected:%s", s.State.CloseCause, "11")
+	}
+}
+
+func TestAsOf(t *testing.T) {
+	ts := testServer("./testdata/response/by_number_with_history.xml")
+	defer ts.Close()
+
+	SetAppID("your-token")
+	setTestEnvToRequest(ts)
+
+	s, err := AsOf(testFillinCorpNum, time.Date(2019, 1, 1, 0, 0, 0, 0, currentLocation()))
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+
+	if s.State.PostCode != "3700813" {
+		t.Errorf("PostCode is wrong. result:%s expected:%s", s.State.PostCode, "3700813")
+	}
+
+	if _, err := AsOf(testGunmaCorpNum, time.Now()); err == nil {
+		t.Error("No error occurred.")
+	}
+}
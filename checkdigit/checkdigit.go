@@ -9,10 +9,7 @@ https://www.houjin-bangou.nta.go.jp/documents/checkdigit.pd://www.houjin-bangou.
 */
 package checkdigit
 
-import (
-	"fmt"
-	"strconv"
-)
+import "strconv"
 
 // CalculateCheckDigit は法人番号の 2 〜 13 桁の数値からチェックデジットを算出します。
 func CalculateCheckDigit(corpNum uint64) (int, error) {
@@ -57,11 +54,33 @@ func IsValid(corpNum uint64) (bool, error) {
 	return calcDigit == checkDigit, nil
 }
 
+/*
+Validate は法人番号が正しい形式か検証します。
+
+13 桁でない場合は *LengthError を, チェックデジットが一致しない場合は *MismatchError を返します。
+*/
+func Validate(corpNum uint64) error {
+	calcDigit, err := CalculateCheckDigit(corpNum)
+	if err != nil {
+		return err
+	}
+
+	checkDigit, err := checkDigit(corpNum)
+	if err != nil {
+		return err
+	}
+
+	if calcDigit != checkDigit {
+		return &MismatchError{CorporateNumber: corpNum, CheckDigit: checkDigit, Expected: calcDigit}
+	}
+	return nil
+}
+
 // corpNumStr は法人番号を文字列に変換し桁数を確認します。
 func corpNumStr(corpNum uint64) (string, error) {
 	str := strconv.FormatUint(corpNum, 10)
 	if len(str) != 13 {
-		return "", fmt.Errorf("Corporate Number is 13-digit number. Not %d Digit.", len(str))
+		return "", &LengthError{Length: len(str)}
 	}
 
 	return str, nil
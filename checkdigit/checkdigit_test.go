@@ -1,6 +1,9 @@
 package checkdigit
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 type testCalc struct {
 	CorpNum    uint64
@@ -85,6 +88,29 @@ func TestCalculateCheckDigitError(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	if err := Validate(5070001032626); err != nil {
+		t.Errorf("error! %v", err)
+	}
+
+	err := Validate(4070001032626)
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("error type is wrong. result:%T", err)
+	}
+	if mismatch.CheckDigit != 4 || mismatch.Expected != 5 {
+		t.Errorf("check digit is wrong. result:%d expected:%d", mismatch.CheckDigit, mismatch.Expected)
+	}
+	if err.Error() != "4070001032626: check digit 4 does not match 5" {
+		t.Errorf("Error Message not matched result:%s", err.Error())
+	}
+
+	var length *LengthError
+	if err := Validate(1234); !errors.As(err, &length) || length.Length != 4 {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
 func TestIsValid(t *testing.T) {
 	t.Run("valid", func(t *testing.T) {
 		tests := []uint64{
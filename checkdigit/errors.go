@@ -0,0 +1,39 @@
+package checkdigit
+
+import "fmt"
+
+// LengthError は法人番号が 13 桁でない場合のエラーです。
+type LengthError struct {
+	// 法人番号の桁数
+	Length int
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("Corporate Number is 13-digit number. Not %d Digit.", e.Length)
+}
+
+// DigitError は法人番号に数字以外の文字が含まれる場合のエラーです。
+type DigitError struct {
+	// 入力された文字列
+	Input string
+	// 数字以外の文字
+	Char rune
+}
+
+func (e *DigitError) Error() string {
+	return fmt.Sprintf("Corporate Number must consist of digits. %q contains %q.", e.Input, e.Char)
+}
+
+// MismatchError はチェックデジットが 2 〜 13 桁の数値からの算出値と一致しない場合のエラーです。
+type MismatchError struct {
+	// 法人番号
+	CorporateNumber uint64
+	// 法人番号の 1 桁目のチェックデジット
+	CheckDigit int
+	// 2 〜 13 桁の数値からの算出値
+	Expected int
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("%013d: check digit %d does not match %d", e.CorporateNumber, e.CheckDigit, e.Expected)
+}
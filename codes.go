@@ -0,0 +1,301 @@
+package corp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Process は処理区分です。
+type Process string
+
+const (
+	// 新規
+	ProcessNew Process = "01"
+	// 商号又は名称の変更
+	ProcessNameChanged Process = "11"
+	// 国内所在地の変更
+	ProcessDomesticAddressChanged Process = "12"
+	// 国外所在地の変更
+	ProcessForeignAddressChanged Process = "13"
+	// 登記記録の閉鎖等
+	ProcessClosed Process = "21"
+	// 登記記録の復活等
+	ProcessRestored Process = "22"
+	// 吸収合併
+	ProcessAbsorbed Process = "71"
+	// 吸収合併無効
+	ProcessMergerNullified Process = "72"
+	// 商号の登記の抹消
+	ProcessTradeNameErased Process = "81"
+	// 削除
+	ProcessDeleted Process = "99"
+)
+
+// Kind は法人種別です。
+type Kind uint16
+
+const (
+	// 国の機関
+	KindNationalAgency Kind = 101
+	// 地方公共団体
+	KindLocalGovernment Kind = 201
+	// 株式会社
+	KindStockCompany Kind = 301
+	// 有限会社
+	KindLimitedCompany Kind = 302
+	// 合名会社
+	KindGeneralPartnership Kind = 303
+	// 合資会社
+	KindLimitedPartnership Kind = 304
+	// 合同会社
+	KindLLC Kind = 305
+	// その他の設立登記法人
+	KindOtherRegistered Kind = 399
+	// 外国会社等
+	KindForeignCompany Kind = 401
+	// その他
+	KindOther Kind = 499
+)
+
+// CloseCause は登記記録の閉鎖等の事由です。
+type CloseCause string
+
+const (
+	// 清算の結了等
+	CloseCauseLiquidation CloseCause = "01"
+	// 合併による解散等
+	CloseCauseMerger CloseCause = "11"
+	// 登記官による閉鎖
+	CloseCauseRegistrar CloseCause = "21"
+	// その他の清算の結了等
+	CloseCauseOther CloseCause = "31"
+)
+
+var (
+	// 処理区分
+	processes = map[Process]string{
+		ProcessNew:                    "新規",
+		ProcessNameChanged:            "商号又は名称の変更",
+		ProcessDomesticAddressChanged: "国内所在地の変更",
+		ProcessForeignAddressChanged:  "国外所在地の変更",
+		ProcessClosed:                 "登記記録の閉鎖等",
+		ProcessRestored:               "登記記録の復活等",
+		ProcessAbsorbed:               "吸収合併",
+		ProcessMergerNullified:        "吸収合併無効",
+		ProcessTradeNameErased:        "商号の登記の抹消",
+		ProcessDeleted:                "削除",
+	}
+
+	// 法人種別
+	kinds = map[Kind]string{
+		KindNationalAgency:     "国の機関",
+		KindLocalGovernment:    "地方公共団体",
+		KindStockCompany:       "株式会社",
+		KindLimitedCompany:     "有限会社",
+		KindGeneralPartnership: "合名会社",
+		KindLimitedPartnership: "合資会社",
+		KindLLC:                "合同会社",
+		KindOtherRegistered:    "その他の設立登記法人",
+		KindForeignCompany:     "外国会社等",
+		KindOther:              "その他",
+	}
+
+	// 登記記録の閉鎖等の事由
+	closeCauses = map[CloseCause]string{
+		CloseCauseLiquidation: "清算の結了等",
+		CloseCauseMerger:      "合併による解散等",
+		CloseCauseRegistrar:   "登記官による閉鎖",
+		CloseCauseOther:       "その他の清算の結了等",
+	}
+
+	// 法人種別とリクエストの法人種別コードの対応
+	requestKinds = map[Kind]string{
+		KindNationalAgency:     "01",
+		KindLocalGovernment:    "02",
+		KindStockCompany:       "03",
+		KindLimitedCompany:     "03",
+		KindGeneralPartnership: "03",
+		KindLimitedPartnership: "03",
+		KindLLC:                "03",
+		KindOtherRegistered:    "03",
+		KindForeignCompany:     "04",
+		KindOther:              "04",
+	}
+)
+
+/*
+ParseProcess は文字列を処理区分に変換します。
+
+未知の処理区分の場合はエラーを返します。
+*/
+func ParseProcess(s string) (Process, error) {
+	p := Process(s)
+	if !p.IsValid() {
+		return "", fmt.Errorf("unknown process code: %q", s)
+	}
+	return p, nil
+}
+
+// IsValid は既知の処理区分か判定します。
+func (p Process) IsValid() bool {
+	_, ok := processes[p]
+	return ok
+}
+
+// String は処理区分のコード(例: 01)を返します。表示用テキストは Label で取得してください。
+func (p Process) String() string {
+	return string(p)
+}
+
+// MarshalText は処理区分のコードを返します。
+func (p Process) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+/*
+UnmarshalText は処理区分のコードを読み込みます。
+
+Web-API に新たな処理区分が追加された場合に備え, 未知のコードもエラーにせず保持します。
+既知の処理区分かは IsValid で確認してください。
+*/
+func (p *Process) UnmarshalText(b []byte) error {
+	*p = Process(b)
+	return nil
+}
+
+/*
+ParseKind は文字列を法人種別に変換します。
+
+数値でない場合や未知の法人種別の場合はエラーを返します。
+*/
+func ParseKind(s string) (Kind, error) {
+	n, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid kind code: %q", s)
+	}
+
+	k := Kind(n)
+	if !k.IsValid() {
+		return 0, fmt.Errorf("unknown kind code: %q", s)
+	}
+	return k, nil
+}
+
+// IsValid は既知の法人種別か判定します。
+func (k Kind) IsValid() bool {
+	_, ok := kinds[k]
+	return ok
+}
+
+// String は法人種別のコード(例: 301)を返します。表示用テキストは Label で取得してください。
+func (k Kind) String() string {
+	return strconv.FormatUint(uint64(k), 10)
+}
+
+/*
+RequestKind は法人種別に対応するリクエストの法人種別コードを返します。
+
+01:国の機関, 02:地方公共団体, 03:設立登記法人, 04:外国会社等・その他
+未知の法人種別の場合は空文字を返します。
+*/
+func (k Kind) RequestKind() string {
+	return requestKinds[k]
+}
+
+// KindsOfRequestKind はリクエストの法人種別コードに含まれる法人種別を返します。
+func KindsOfRequestKind(code string) []Kind {
+	var ret []Kind
+	for _, k := range []Kind{
+		KindNationalAgency, KindLocalGovernment,
+		KindStockCompany, KindLimitedCompany, KindGeneralPartnership, KindLimitedPartnership, KindLLC, KindOtherRegistered,
+		KindForeignCompany, KindOther,
+	} {
+		if requestKinds[k] == code {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// MarshalText は法人種別のコードを返します。未設定の場合は空文字です。
+func (k Kind) MarshalText() ([]byte, error) {
+	if k == 0 {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatUint(uint64(k), 10)), nil
+}
+
+/*
+UnmarshalText は法人種別のコードを読み込みます。
+
+空文字は未設定として扱います。未知のコードもエラーにせず保持します。
+*/
+func (k *Kind) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*k = 0
+		return nil
+	}
+
+	n, err := strconv.ParseUint(string(b), 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid kind code: %q", string(b))
+	}
+	*k = Kind(n)
+	return nil
+}
+
+// MarshalJSON は法人種別を数値で出力します。
+func (k Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint16(k))
+}
+
+// UnmarshalJSON は数値または文字列の法人種別を読み込みます。
+func (k *Kind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return k.UnmarshalText([]byte(s))
+	}
+
+	var n uint16
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("invalid kind code: %s", string(b))
+	}
+	*k = Kind(n)
+	return nil
+}
+
+/*
+ParseCloseCause は文字列を登記記録の閉鎖等の事由に変換します。
+
+未知の事由の場合はエラーを返します。
+*/
+func ParseCloseCause(s string) (CloseCause, error) {
+	c := CloseCause(s)
+	if !c.IsValid() {
+		return "", fmt.Errorf("unknown close cause code: %q", s)
+	}
+	return c, nil
+}
+
+// IsValid は既知の登記記録の閉鎖等の事由か判定します。
+func (c CloseCause) IsValid() bool {
+	_, ok := closeCauses[c]
+	return ok
+}
+
+// String は登記記録の閉鎖等の事由のコード(例: 01)を返します。表示用テキストは Label で取得してください。
+func (c CloseCause) String() string {
+	return string(c)
+}
+
+// MarshalText は登記記録の閉鎖等の事由のコードを返します。
+func (c CloseCause) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText は登記記録の閉鎖等の事由のコードを読み込みます。未知のコードもエラーにせず保持します。
+func (c *CloseCause) UnmarshalText(b []byte) error {
+	*c = CloseCause(b)
+	return nil
+}
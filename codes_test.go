@@ -0,0 +1,175 @@
+package corp
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestProcess(t *testing.T) {
+	for p, text := range processes {
+		if !p.IsValid() {
+			t.Errorf("%s: IsValid return false.", string(p))
+		}
+
+		if p.String() != string(p) {
+			t.Errorf("String return wrong value result:%s expected:%s", p.String(), string(p))
+		}
+		if p.Label(LocaleJa) != text {
+			t.Errorf("Label return wrong value result:%s expected:%s", p.Label(LocaleJa), text)
+		}
+
+		parsed, err := ParseProcess(string(p))
+		if err != nil || parsed != p {
+			t.Errorf("ParseProcess return wrong value result:%s err:%v", string(parsed), err)
+		}
+	}
+
+	unknown := Process("98")
+	if unknown.IsValid() {
+		t.Error("IsValid return true for unknown process.")
+	}
+
+	if unknown.String() != "98" || unknown.Label(LocaleJa) != "不明な処理区分(98)" {
+		t.Errorf("String return wrong value result:%s %s", unknown.String(), unknown.Label(LocaleJa))
+	}
+
+	if _, err := ParseProcess("98"); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+func TestKind(t *testing.T) {
+	for k, text := range kinds {
+		if !k.IsValid() {
+			t.Errorf("%d: IsValid return false.", uint16(k))
+		}
+
+		if k.String() != fmt.Sprint(uint16(k)) {
+			t.Errorf("String return wrong value result:%s expected:%d", k.String(), uint16(k))
+		}
+		if k.Label(LocaleJa) != text {
+			t.Errorf("Label return wrong value result:%s expected:%s", k.Label(LocaleJa), text)
+		}
+	}
+
+	if Kind(402).IsValid() || Kind(402).String() != "402" || Kind(402).Label(LocaleJa) != "不明な法人種別(402)" {
+		t.Errorf("unknown kind is not handled. result:%s %s", Kind(402).String(), Kind(402).Label(LocaleJa))
+	}
+
+	if Kind(0).Label(LocaleJa) != "" {
+		t.Errorf("Label return wrong value result:%s expected:%s", Kind(0).Label(LocaleJa), "")
+	}
+
+	if k, err := ParseKind("305"); err != nil || k != KindLLC {
+		t.Errorf("ParseKind return wrong value result:%d err:%v", k, err)
+	}
+
+	for _, s := range []string{"", "abc", "402"} {
+		if _, err := ParseKind(s); err == nil {
+			t.Errorf("%q: No error occurred.", s)
+		}
+	}
+}
+
+func TestKindRequestKind(t *testing.T) {
+	tests := map[Kind]string{
+		KindNationalAgency:  "01",
+		KindLocalGovernment: "02",
+		KindStockCompany:    "03",
+		KindOtherRegistered: "03",
+		KindForeignCompany:  "04",
+		KindOther:           "04",
+		Kind(402):           "",
+	}
+
+	for k, expected := range tests {
+		if k.RequestKind() != expected {
+			t.Errorf("%d: RequestKind return wrong value result:%s expected:%s", uint16(k), k.RequestKind(), expected)
+		}
+	}
+
+	expected := []Kind{KindForeignCompany, KindOther}
+	if !reflect.DeepEqual(KindsOfRequestKind("04"), expected) {
+		t.Errorf("KindsOfRequestKind return wrong value result:%v expected:%v", KindsOfRequestKind("04"), expected)
+	}
+
+	if len(KindsOfRequestKind("03")) != 6 {
+		t.Errorf("KindsOfRequestKind return wrong length result:%d expected:%d", len(KindsOfRequestKind("03")), 6)
+	}
+}
+
+func TestCloseCause(t *testing.T) {
+	for c, text := range closeCauses {
+		if !c.IsValid() || c.String() != string(c) || c.Label(LocaleJa) != text {
+			t.Errorf("%s: String return wrong value result:%s %s expected:%s", string(c), c.String(), c.Label(LocaleJa), text)
+		}
+	}
+
+	if CloseCause("99").IsValid() || CloseCause("99").String() != "99" || CloseCause("99").Label(LocaleJa) != "不明な閉鎖等の事由(99)" {
+		t.Errorf("unknown close cause is not handled. result:%s %s", CloseCause("99").String(), CloseCause("99").Label(LocaleJa))
+	}
+
+	if _, err := ParseCloseCause("99"); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+func TestCodesEncoding(t *testing.T) {
+	type codes struct {
+		Process    Process    `xml:"process" json:"process"`
+		Kind       Kind       `xml:"kind" json:"kind"`
+		CloseCause CloseCause `xml:"closeCause" json:"closeCause"`
+	}
+
+	t.Run("XML", func(t *testing.T) {
+		var c codes
+		err := xml.Unmarshal([]byte("<codes><process>71</process><kind>301</kind><closeCause>11</closeCause></codes>"), &c)
+		if err != nil {
+			t.Fatalf("failed to parse XML: %v", err)
+		}
+
+		expected := codes{ProcessAbsorbed, KindStockCompany, CloseCauseMerger}
+		if c != expected {
+			t.Errorf("result:%+v expected:%+v", c, expected)
+		}
+
+		b, _ := xml.Marshal(c)
+		if string(b) != "<codes><process>71</process><kind>301</kind><closeCause>11</closeCause></codes>" {
+			t.Errorf("MarshalXML return wrong value result:%s", b)
+		}
+
+		if err := xml.Unmarshal([]byte("<codes><process>99</process><kind/><closeCause/></codes>"), &c); err != nil {
+			t.Fatalf("failed to parse XML: %v", err)
+		}
+		if c.Kind != 0 || c.CloseCause != "" {
+			t.Errorf("empty codes are wrong. result:%+v", c)
+		}
+
+		if err := xml.Unmarshal([]byte("<codes><kind>abc</kind></codes>"), &c); err == nil {
+			t.Error("No error occurred.")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		b, err := json.Marshal(codes{ProcessAbsorbed, KindStockCompany, CloseCauseMerger})
+		if err != nil {
+			t.Fatalf("failed to marshal: %v", err)
+		}
+
+		expected := `{"process":"71","kind":301,"closeCause":"11"}`
+		if string(b) != expected {
+			t.Errorf("MarshalJSON return wrong value result:%s expected:%s", b, expected)
+		}
+
+		var c codes
+		if err := json.Unmarshal([]byte(`{"process":"12","kind":"305","closeCause":""}`), &c); err != nil {
+			t.Fatalf("failed to unmarshal: %v", err)
+		}
+		if c.Process != ProcessDomesticAddressChanged || c.Kind != KindLLC {
+			t.Errorf("UnmarshalJSON return wrong value result:%+v", c)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package corp
+
+import (
+	"sort"
+	"strings"
+)
+
+// Predicate は法人情報の絞り込み条件です。
+type Predicate func(c Corporation) bool
+
+// IsAvailable は処理区分が 99(削除) 以外の法人情報に一致します。
+func IsAvailable() Predicate {
+	return func(c Corporation) bool { return c.Available() }
+}
+
+// IsLatest は最新履歴の法人情報に一致します。
+func IsLatest() Predicate {
+	return func(c Corporation) bool { return c.Latest }
+}
+
+// IsVisible は検索対象除外(Hihyoji)でない法人情報に一致します。
+func IsVisible() Predicate {
+	return func(c Corporation) bool { return !c.Hihyoji }
+}
+
+// IsClosed は登記記録が閉鎖されている法人情報に一致します。
+func IsClosed() Predicate {
+	return func(c Corporation) bool { return c.Closed() }
+}
+
+// OfKind は法人種別が kinds のいずれかに一致します。
+func OfKind(kinds ...Kind) Predicate {
+	return func(c Corporation) bool {
+		for _, k := range kinds {
+			if c.Kind == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// InPrefecture は都道府県コードが codes のいずれかに一致します。
+func InPrefecture(codes ...uint8) Predicate {
+	return func(c Corporation) bool {
+		for _, code := range codes {
+			if c.PrefectureCode == code {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// InMunicipality は所在地が団体コード codes のいずれかの市区町村にあるものに一致します。比較の規則は Corporation.InMunicipality と同じです。
+func InMunicipality(codes ...string) Predicate {
+	return func(c Corporation) bool {
+		for _, code := range codes {
+			if c.InMunicipality(code) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// And はすべての条件に一致します。
+func And(preds ...Predicate) Predicate {
+	return func(c Corporation) bool {
+		for _, p := range preds {
+			if !p(c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or はいずれかの条件に一致します。
+func Or(preds ...Predicate) Predicate {
+	return func(c Corporation) bool {
+		for _, p := range preds {
+			if p(c) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not は条件に一致しない場合に一致します。
+func Not(pred Predicate) Predicate {
+	return func(c Corporation) bool { return !pred(c) }
+}
+
+/*
+Filter はすべての条件に一致する法人情報のみを含むレスポンスを返します。
+
+総件数(Count)は絞り込み後の件数になります。元のレスポンスは変更しません。
+*/
+func (r Response) Filter(preds ...Predicate) Response {
+	match := And(preds...)
+	corps := make([]Corporation, 0, len(r.Corporations))
+	for _, c := range r.Corporations {
+		if match(c) {
+			corps = append(corps, c)
+		}
+	}
+	return r.with(corps)
+}
+
+// GroupByNumber は法人情報を法人番号ごとにまとめます。各法人の法人情報はレスポンス中の順序を保ちます。
+func (r Response) GroupByNumber() map[uint64][]Corporation {
+	groups := map[uint64][]Corporation{}
+	for _, c := range r.Corporations {
+		groups[c.CorporateNumber] = append(groups[c.CorporateNumber], c)
+	}
+	return groups
+}
+
+/*
+ToMap は法人番号ごとに 1 件の法人情報を返します。
+
+同じ法人番号の法人情報が複数ある場合は最新履歴(Latest)のものを,
+最新履歴がない場合は一連番号(SequenceNumber)が最も大きいものを選びます。
+*/
+func (r Response) ToMap() map[uint64]Corporation {
+	m := map[uint64]Corporation{}
+	for _, c := range r.Corporations {
+		if current, ok := m[c.CorporateNumber]; ok && !preferred(c, current) {
+			continue
+		}
+		m[c.CorporateNumber] = c
+	}
+	return m
+}
+
+// Dedup は法人番号ごとに ToMap と同じ規則で 1 件に絞ったレスポンスを返します。法人の順序は最初に出現した順です。
+func (r Response) Dedup() Response {
+	m := r.ToMap()
+	corps := make([]Corporation, 0, len(m))
+	for _, c := range r.Corporations {
+		if selected, ok := m[c.CorporateNumber]; ok {
+			corps = append(corps, selected)
+			delete(m, c.CorporateNumber)
+		}
+	}
+	return r.with(corps)
+}
+
+// Order は法人情報の並び順です。a を b より前にする場合 true を返します。
+type Order func(a, b Corporation) bool
+
+// OrderByFurigana はフリガナ順です。
+func OrderByFurigana(a, b Corporation) bool {
+	return a.Furigana < b.Furigana
+}
+
+// OrderByName は商号または名称順です。
+func OrderByName(a, b Corporation) bool {
+	return a.Name < b.Name
+}
+
+// OrderByAssignmentDate は法人番号指定年月日順です。
+func OrderByAssignmentDate(a, b Corporation) bool {
+	return displayDate(a.AssignmentDate) < displayDate(b.AssignmentDate)
+}
+
+// OrderByUpdateDate は更新年月日順です。
+func OrderByUpdateDate(a, b Corporation) bool {
+	return displayDate(a.UpdateDate) < displayDate(b.UpdateDate)
+}
+
+// Reverse は逆順の並び順を返します。
+func (o Order) Reverse() Order {
+	return func(a, b Corporation) bool { return o(b, a) }
+}
+
+// Sort は法人情報を並べ替えたレスポンスを返します。並び順が同じ法人情報は元の順序を保ちます。
+func (r Response) Sort(order Order) Response {
+	corps := append([]Corporation{}, r.Corporations...)
+	sort.SliceStable(corps, func(i, j int) bool { return order(corps[i], corps[j]) })
+	return r.with(corps)
+}
+
+/*
+MergeResponses は複数のレスポンスを 1 つにまとめます。
+
+分割して取得したレスポンスや複数の検索結果をまとめる際に利用します。
+同一の法人情報は 1 件にまとめ, 総件数(Count)はまとめた後の件数になります。
+最終更新年月日(LastUpdateDate)は最も新しいもの, 分割番号と分割数は 1 になります。
+*/
+func MergeResponses(responses ...Response) Response {
+	var merged Response
+	seen := map[string]struct{}{}
+	for _, r := range responses {
+		if r.LastUpdateDate != nil && displayDate(r.LastUpdateDate) > displayDate(merged.LastUpdateDate) {
+			merged.LastUpdateDate = r.LastUpdateDate
+		}
+
+		for _, c := range r.Corporations {
+			key := recordKey(c)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			merged.Corporations = append(merged.Corporations, c)
+		}
+	}
+
+	merged.Count = uint32(len(merged.Corporations))
+	merged.DivideNumber = 1
+	merged.DevideSize = 1
+	return merged
+}
+
+func (r Response) with(corps []Corporation) Response {
+	r.Corporations = corps
+	r.Count = uint32(len(corps))
+	return r
+}
+
+// preferred は同じ法人番号の法人情報のうち a を b より優先するか判定します。
+func preferred(a, b Corporation) bool {
+	if a.Latest != b.Latest {
+		return a.Latest
+	}
+	return a.SequenceNumber > b.SequenceNumber
+}
+
+// recordKey は法人情報のレコードを識別する文字列を返します。一連番号はレスポンスごとに異なるため含めません。
+func recordKey(c Corporation) string {
+	return strings.Join([]string{
+		eventKey(c),
+		c.Name,
+		c.StreetNumber,
+	}, ":")
+}
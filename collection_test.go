@@ -0,0 +1,146 @@
+package corp
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testCollection() Response {
+	d := func(year int, month time.Month, day int) *Date {
+		date := Date(time.Date(year, month, day, 0, 0, 0, 0, currentLocation()))
+		return &date
+	}
+	closeDate := d(2022, 4, 1)
+
+	return Response{
+		Count:        5,
+		DivideNumber: 1,
+		DevideSize:   1,
+		Corporations: []Corporation{
+			{SequenceNumber: 1, CorporateNumber: testFillinCorpNum, Process: "01", Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: 301, PrefectureCode: 10, AssignmentDate: d(2016, 9, 5), UpdateDate: d(2018, 5, 8)},
+			{SequenceNumber: 2, CorporateNumber: testFillinCorpNum, Process: "12", Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: 301, PrefectureCode: 10, AssignmentDate: d(2016, 9, 5), UpdateDate: d(2021, 6, 9), Latest: true},
+			{SequenceNumber: 3, CorporateNumber: testGunmaCorpNum, Process: "01", Name: "群馬県", Furigana: "グンマケン", Kind: 201, PrefectureCode: 10, AssignmentDate: d(2015, 10, 5), UpdateDate: d(2015, 10, 5), Latest: true},
+			{SequenceNumber: 4, CorporateNumber: 1010401089234, Process: "71", Name: "グーグル合同会社", Furigana: "グーグル", Kind: 305, PrefectureCode: 13, AssignmentDate: d(2015, 10, 5), UpdateDate: d(2022, 4, 8), CloseDate: closeDate, Latest: true, Hihyoji: true},
+			{SequenceNumber: 5, CorporateNumber: 1010401089234, Process: "99"},
+		},
+	}
+}
+
+func TestResponseFilter(t *testing.T) {
+	res := testCollection()
+
+	tests := []struct {
+		name     string
+		preds    []Predicate
+		expected []uint32
+	}{
+		{"Available", []Predicate{IsAvailable()}, []uint32{1, 2, 3, 4}},
+		{"Latest", []Predicate{IsLatest()}, []uint32{2, 3, 4}},
+		{"Visible", []Predicate{IsAvailable(), IsVisible()}, []uint32{1, 2, 3}},
+		{"Closed", []Predicate{IsClosed()}, []uint32{4}},
+		{"Kind", []Predicate{OfKind(301, 305)}, []uint32{1, 2, 4}},
+		{"Prefecture", []Predicate{InPrefecture(10), IsLatest()}, []uint32{2, 3}},
+		{"Or", []Predicate{Or(OfKind(201), IsClosed())}, []uint32{3, 4}},
+		{"Not", []Predicate{IsAvailable(), Not(IsClosed())}, []uint32{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filtered := res.Filter(test.preds...)
+			if !reflect.DeepEqual(sequenceNumbers(filtered), test.expected) {
+				t.Errorf("result:%v expected:%v", sequenceNumbers(filtered), test.expected)
+			}
+
+			if filtered.Count != uint32(len(test.expected)) {
+				t.Errorf("Count is wrong. result:%d expected:%d", filtered.Count, len(test.expected))
+			}
+		})
+	}
+
+	if len(res.Corporations) != 5 || res.Count != 5 {
+		t.Error("original response is modified.")
+	}
+}
+
+func TestResponseGroupByNumber(t *testing.T) {
+	groups := testCollection().GroupByNumber()
+
+	if len(groups) != 3 {
+		t.Fatalf("groups length is wrong. result:%d expected:%d", len(groups), 3)
+	}
+
+	if len(groups[testFillinCorpNum]) != 2 || groups[testFillinCorpNum][0].SequenceNumber != 1 {
+		t.Errorf("group is wrong. result:%+v", groups[testFillinCorpNum])
+	}
+}
+
+func TestResponseToMapAndDedup(t *testing.T) {
+	res := testCollection()
+
+	m := res.ToMap()
+	if m[testFillinCorpNum].SequenceNumber != 2 {
+		t.Errorf("latest corporation is not selected. result:%d", m[testFillinCorpNum].SequenceNumber)
+	}
+
+	if m[1010401089234].SequenceNumber != 4 {
+		t.Errorf("latest corporation is not selected. result:%d", m[1010401089234].SequenceNumber)
+	}
+
+	deduped := res.Dedup()
+	if !reflect.DeepEqual(sequenceNumbers(deduped), []uint32{2, 3, 4}) {
+		t.Errorf("Dedup is wrong. result:%v", sequenceNumbers(deduped))
+	}
+}
+
+func TestResponseSort(t *testing.T) {
+	res := testCollection().Filter(IsAvailable())
+
+	tests := []struct {
+		name     string
+		order    Order
+		expected []uint32
+	}{
+		{"Furigana", OrderByFurigana, []uint32{3, 4, 1, 2}},
+		{"Name", OrderByName, []uint32{4, 1, 2, 3}},
+		{"AssignmentDate", OrderByAssignmentDate, []uint32{3, 4, 1, 2}},
+		{"UpdateDate Reverse", Order(OrderByUpdateDate).Reverse(), []uint32{4, 2, 1, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorted := res.Sort(test.order)
+			if !reflect.DeepEqual(sequenceNumbers(sorted), test.expected) {
+				t.Errorf("result:%v expected:%v", sequenceNumbers(sorted), test.expected)
+			}
+		})
+	}
+}
+
+func TestMergeResponses(t *testing.T) {
+	d := Date(time.Date(2021, 7, 20, 0, 0, 0, 0, currentLocation()))
+	res := testCollection()
+	page1 := Response{Count: 5, DivideNumber: 1, DevideSize: 2, Corporations: res.Corporations[:3]}
+	page2 := Response{LastUpdateDate: &d, Count: 5, DivideNumber: 2, DevideSize: 2, Corporations: res.Corporations[2:]}
+
+	merged := MergeResponses(page1, page2)
+	if !reflect.DeepEqual(sequenceNumbers(merged), []uint32{1, 2, 3, 4, 5}) {
+		t.Errorf("Corporations is wrong. result:%v", sequenceNumbers(merged))
+	}
+
+	if merged.Count != 5 || merged.DivideNumber != 1 || merged.DevideSize != 1 {
+		t.Errorf("Count or divide is wrong. result:%d %d/%d", merged.Count, merged.DivideNumber, merged.DevideSize)
+	}
+
+	if merged.LastUpdateDate.String() != "2021-07-20" {
+		t.Errorf("LastUpdateDate is wrong. result:%s", merged.LastUpdateDate)
+	}
+}
+
+func sequenceNumbers(res Response) []uint32 {
+	var numbers []uint32
+	for _, c := range res.Corporations {
+		numbers = append(numbers, c.SequenceNumber)
+	}
+	return numbers
+}
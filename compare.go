@@ -0,0 +1,154 @@
+package corp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldChange は法人情報の 1 フィールドの変更です。
+type FieldChange struct {
+	// フィールド名
+	Field string
+	// 表示名(日本語)
+	Label string
+	// 表示名(英語)
+	EnLabel string
+	// 変更前の値
+	Old string
+	// 変更後の値
+	New string
+}
+
+// FieldChanges は Compare で取得した変更の一覧です。
+type FieldChanges []FieldChange
+
+// comparedField は比較対象のフィールドです。
+type comparedField struct {
+	name  string
+	value func(c Corporation) string
+}
+
+/*
+比較対象のフィールド
+
+一連番号(SequenceNumber), 更新年月日(UpdateDate) など
+レコードの管理用のフィールドは比較しません。
+*/
+var comparedFields = []comparedField{
+	{"Name", func(c Corporation) string { return c.Name }},
+	{"Furigana", func(c Corporation) string { return c.Furigana }},
+	{"Kind", func(c Corporation) string { return uintString(uint64(c.Kind)) }},
+	{"PrefectureName", func(c Corporation) string { return c.PrefectureName }},
+	{"CityName", func(c Corporation) string { return c.CityName }},
+	{"StreetNumber", func(c Corporation) string { return c.StreetNumber }},
+	{"PrefectureCode", func(c Corporation) string { return uintString(uint64(c.PrefectureCode)) }},
+	{"CityCode", func(c Corporation) string { return uintString(uint64(c.CityCode)) }},
+	{"PostCode", func(c Corporation) string { return c.PostCode }},
+	{"AddressOutside", func(c Corporation) string { return c.AddressOutside }},
+	{"CloseDate", func(c Corporation) string { return displayDate(c.CloseDate) }},
+	{"CloseCause", func(c Corporation) string { return string(c.CloseCause) }},
+	{"SuccessorCorporateNumber", func(c Corporation) string { return uintString(c.SuccessorCorporateNumber) }},
+	{"EnName", func(c Corporation) string { return c.EnName }},
+	{"EnPrefectureName", func(c Corporation) string { return c.EnPrefectureName }},
+	{"EnCityName", func(c Corporation) string { return c.EnCityName }},
+	{"EnAddressOutside", func(c Corporation) string { return c.EnAddressOutside }},
+}
+
+/*
+Compare は 2 つの法人情報を比較し, 値が異なるフィールドを返します。
+
+商号, フリガナ, 英語表記, 所在地, 郵便番号, 法人種別, 閉鎖等の情報, 承継先法人番号を比較します。
+一連番号(SequenceNumber)や更新年月日(UpdateDate)などレコードの管理用のフィールドは無視します。
+値は表示用の文字列に変換され, 未設定の場合は空文字になります。
+*/
+func Compare(old, new Corporation) FieldChanges {
+	var changes FieldChanges
+	for _, f := range comparedFields {
+		o, n := f.value(old), f.value(new)
+		if o == n {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Field:   f.name,
+			Label:   FieldLabel(LocaleJa, f.name),
+			EnLabel: FieldLabel(LocaleEn, f.name),
+			Old:     o,
+			New:     n,
+		})
+	}
+	return changes
+}
+
+// Fields は変更のあったフィールド名を返します。
+func (changes FieldChanges) Fields() []string {
+	fields := make([]string, 0, len(changes))
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	return fields
+}
+
+// Changed は指定したフィールドに変更があるか判定します。
+func (changes FieldChanges) Changed(field string) bool {
+	for _, c := range changes {
+		if c.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Format は変更内容を指定した言語で 1 行 1 フィールドの表示用テキストに変換します。
+
+	国内所在地(丁目番地等): 本町４８番地 → 飯塚町１４７番地４
+
+未設定の値は「(なし)」と表示します。
+*/
+func (changes FieldChanges) Format(l Locale) string {
+	var b strings.Builder
+	empty := Translate(l, "format.empty")
+	for _, c := range changes {
+		fmt.Fprintf(&b, "%s: %s %s %s\n", FieldLabel(l, c.Field), displayValue(c.Old, empty), Translate(l, "format.arrow"), displayValue(c.New, empty))
+	}
+	return b.String()
+}
+
+// String は既定の言語で Format の結果を返します。
+func (changes FieldChanges) String() string {
+	return changes.Format(CurrentLocale())
+}
+
+// EnString は英語で Format の結果を返します。
+func (changes FieldChanges) EnString() string {
+	return changes.Format(LocaleEn)
+}
+
+// Diff は遷移前後の法人情報のフィールド単位の変更を返します。
+func (t Transition) Diff() FieldChanges {
+	return Compare(t.From, t.To)
+}
+
+func displayValue(v string, empty string) string {
+	if v == "" {
+		return empty
+	}
+	return v
+}
+
+// displayDate は日付を表示用の文字列に変換します。未設定の場合は空文字を返します。
+func displayDate(d *Date) string {
+	if isZeroDate(d) {
+		return ""
+	}
+	return d.String()
+}
+
+// uintString は数値を文字列に変換します。0 は未設定として空文字を返します。
+func uintString(n uint64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatUint(n, 10)
+}
@@ -0,0 +1,80 @@
+package corp
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	updated := Date(time.Date(2021, 6, 9, 0, 0, 0, 0, currentLocation()))
+	closeDate := Date(time.Date(2022, 4, 1, 0, 0, 0, 0, currentLocation()))
+
+	old := Corporation{
+		SequenceNumber: 1,
+		Name:           "株式会社フィルイン",
+		Kind:           301,
+		PrefectureName: "群馬県",
+		CityName:       "高崎市",
+		StreetNumber:   "本町４８番地",
+		PostCode:       "3700813",
+		CloseDate:      &Date{},
+	}
+
+	t.Run("Changed", func(t *testing.T) {
+		new := old
+		new.SequenceNumber = 2
+		new.UpdateDate = &updated
+		new.StreetNumber = "飯塚町１４７番地４"
+		new.PostCode = "3700069"
+		new.CloseDate = &closeDate
+		new.SuccessorCorporateNumber = testGunmaCorpNum
+
+		changes := Compare(old, new)
+		expected := []string{"StreetNumber", "PostCode", "CloseDate", "SuccessorCorporateNumber"}
+		if !reflect.DeepEqual(changes.Fields(), expected) {
+			t.Fatalf("Fields is wrong. result:%v expected:%v", changes.Fields(), expected)
+		}
+
+		c := changes[0]
+		if c.Label != "国内所在地(丁目番地等)" || c.EnLabel != "Street Number" || c.Old != "本町４８番地" || c.New != "飯塚町１４７番地４" {
+			t.Errorf("FieldChange is wrong. result:%+v", c)
+		}
+
+		if changes[2].Old != "" || changes[2].New != "2022-04-01" {
+			t.Errorf("CloseDate change is wrong. result:%+v", changes[2])
+		}
+
+		if !changes.Changed("PostCode") || changes.Changed("Name") {
+			t.Error("Changed return wrong value.")
+		}
+	})
+
+	t.Run("Bookkeeping Fields Only", func(t *testing.T) {
+		new := old
+		new.SequenceNumber = 2
+		new.UpdateDate = &updated
+		new.Latest = true
+
+		if changes := Compare(old, new); len(changes) != 0 {
+			t.Errorf("changes length is wrong. result:%v", changes.Fields())
+		}
+	})
+}
+
+func TestFieldChangesString(t *testing.T) {
+	changes := FieldChanges{
+		{"StreetNumber", "国内所在地(丁目番地等)", "Street Number", "本町４８番地", "飯塚町１４７番地４"},
+		{"EnName", "商号又は名称(英語表記)", "English Name", "", "Fillin Inc."},
+	}
+
+	expected := "国内所在地(丁目番地等): 本町４８番地 → 飯塚町１４７番地４\n商号又は名称(英語表記): (なし) → Fillin Inc.\n"
+	if changes.String() != expected {
+		t.Errorf("String is wrong.\nresult:\n%s\nexpected:\n%s", changes.String(), expected)
+	}
+
+	expected = "Street Number: 本町４８番地 -> 飯塚町１４７番地４\nEnglish Name: (none) -> Fillin Inc.\n"
+	if changes.EnString() != expected {
+		t.Errorf("EnString is wrong.\nresult:\n%s\nexpected:\n%s", changes.EnString(), expected)
+	}
+}
@@ -23,8 +23,6 @@ package corp
 
 import (
 	"encoding/xml"
-	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -74,6 +72,8 @@ from, to は YYYY-MM-DD 形式の日付文字列を指定してください。
 
 address は空文字, 「都道府県コード」(2文字)または「都道府県コード+市区町村コード」(5文字)を
 指定できます。空文字の場合, from, to に指定した期間のみで検索を行います。
+合併等で廃止された市区町村コードは address.ResolveMunicipalityCode で現在の市区町村コードに変換します。
+収録している変更は一部のため(address.MunicipalityChanges を参照), 変換できない団体コードはそのまま指定します。
 
 各コードについては次のリンクを参照してください。
 
@@ -82,8 +82,7 @@ address は空文字, 「都道府県コード」(2文字)または「都道府
 ・都道府県コード+市区町村コード: https://www.soumu.go.jp/denshijiti/code.html
 */
 func DiffSearch(from string, to string, address string) (Response, error) {
-	builder := request.NewDiff(appID, from, to, address, []string{}, 1)
-	return responseByURLBuilder(builder)
+	return diffSearch(from, to, address, 1)
 }
 
 /*
@@ -93,6 +92,8 @@ NameSearch は法人名と地域で法人情報を検索します。
 
 address は空文字, 「都道府県コード」(2文字)または「都道府県コード+市区町村コード」(5文字)を
 指定できます。空文字の場合, address に指定した法人名のみで検索を行います。
+合併等で廃止された市区町村コードは address.ResolveMunicipalityCode で現在の市区町村コードに変換します。
+収録している変更は一部のため(address.MunicipalityChanges を参照), 変換できない団体コードはそのまま指定します。
 
 各コードについては次のリンクを参照してください。
 
@@ -143,20 +144,14 @@ func responseByURLBuilder(builder request.URLBuilder) (Response, error) {
 		str := string(body)
 		strs := strings.Split(str, ",")
 		if len(strs) == 2 {
-			return res, fmt.Errorf("%s:%s", strs[0], strs[1])
+			return res, &APIError{StatusCode: statusCode, Code: strs[0], Message: strs[1]}
 		}
-		return res, errors.New(str)
-	}
-	if statusCode == http.StatusForbidden {
-		return res, fmt.Errorf(
-			"同一アプリケーションIDで一定期間内に多数のアクセスが実行されたため制限されています。",
-		)
-	}
-	if statusCode == http.StatusNotFound {
-		return res, fmt.Errorf("アプリケーションIDが登録されていないまたは無効です。")
+		return res, &APIError{StatusCode: statusCode, Message: str}
 	}
-	if statusCode == http.StatusInternalServerError {
-		return res, fmt.Errorf("法人番号システム Web-API に問題が発生しています。")
+	if statusCode == http.StatusForbidden ||
+		statusCode == http.StatusNotFound ||
+		statusCode == http.StatusInternalServerError {
+		return res, &APIError{StatusCode: statusCode}
 	}
 
 	err = xml.Unmarshal(body, &res)
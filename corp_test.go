@@ -267,6 +267,29 @@ func TestDiffSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("Former Municipality Code", func(t *testing.T) {
+		var address string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			address = r.URL.Query().Get("address")
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			data, _ := os.ReadFile("./testdata/response/diff_search.xml")
+			w.Write(data)
+		}))
+		defer ts.Close()
+
+		SetAppID("your-token")
+		setTestEnvToRequest(ts)
+
+		// 新町(10361)は 2006-01-23 に高崎市(10202)に編入
+		if _, err := DiffSearch("2021-06-09", "2021-06-09", "10361"); err != nil {
+			t.Errorf("error! %v", err)
+		}
+		if address != "10202" {
+			t.Errorf("address is wrong. result:%s expected:%s", address, "10202")
+		}
+	})
+
 	t.Run("Errors", func(t *testing.T) {
 		tests := []struct {
 			name          string
@@ -371,6 +394,29 @@ func TestNameSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("Former Municipality Code", func(t *testing.T) {
+		var address string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			address = r.URL.Query().Get("address")
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			data, _ := os.ReadFile("./testdata/response/name_search.xml")
+			w.Write(data)
+		}))
+		defer ts.Close()
+
+		SetAppID("your-token")
+		setTestEnvToRequest(ts)
+
+		// 新町(10361)は 2006-01-23 に高崎市(10202)に編入
+		if _, err := NameSearch("フィルイン", "10361"); err != nil {
+			t.Errorf("error! %v", err)
+		}
+		if address != "10202" {
+			t.Errorf("address is wrong. result:%s expected:%s", address, "10202")
+		}
+	})
+
 	t.Run("Errors", func(t *testing.T) {
 		tests := []struct {
 			name          string
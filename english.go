@@ -0,0 +1,154 @@
+package corp
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/fillin-inc/go-corp/romaji"
+)
+
+// EnglishStyle は法人格の英語表記の方式です。
+type EnglishStyle uint8
+
+const (
+	// 英語の慣用的な表記(例: 株式会社 → Co., Ltd.)
+	EnglishStyleConventional EnglishStyle = iota
+	// ローマ字の略称(例: 株式会社 → K.K.)
+	// 略称がない法人格は EnglishStyleConventional の表記を使用します。
+	EnglishStyleRomanized
+)
+
+// legalFormEnglish は法人格の英語表記です。
+type legalFormEnglish struct {
+	conventional string
+	romanized    string
+	// 名称の前に置く
+	prefix bool
+}
+
+var legalFormEnglishes = map[LegalForm]legalFormEnglish{
+	LegalFormStockCompany:              {"Co., Ltd.", "K.K.", false},
+	LegalFormLimitedCompany:            {"Ltd.", "Y.K.", false},
+	LegalFormGeneralPartnership:        {"General Partnership", "Gomei Kaisha", false},
+	LegalFormLimitedPartnership:        {"Limited Partnership", "Goshi Kaisha", false},
+	LegalFormLLC:                       {"LLC", "G.K.", false},
+	LegalFormMutualCompany:             {"Mutual Company", "Sogo Kaisha", false},
+	LegalFormGeneralAssociation:        {"General Incorporated Association", "", true},
+	LegalFormGeneralFoundation:         {"General Incorporated Foundation", "", true},
+	LegalFormPublicInterestAssociation: {"Public Interest Incorporated Association", "", true},
+	LegalFormPublicInterestFoundation:  {"Public Interest Incorporated Foundation", "", true},
+	LegalFormMedical:                   {"Medical Corporation", "", true},
+	LegalFormNPO:                       {"Specified Nonprofit Corporation", "", true},
+	LegalFormSocialWelfare:             {"Social Welfare Corporation", "", true},
+	LegalFormSchool:                    {"Educational Corporation", "", true},
+	LegalFormReligious:                 {"Religious Corporation", "", true},
+	LegalFormAdministrativeAgency:      {"Incorporated Administrative Agency", "", true},
+	LegalFormLocalAdministrativeAgency: {"Local Incorporated Administrative Agency", "", true},
+	LegalFormNationalUniversity:        {"National University Corporation", "", true},
+	LegalFormLawFirm:                   {"Legal Professional Corporation", "", true},
+	LegalFormTaxAccountant:             {"Certified Public Tax Accountants' Corporation", "", true},
+	LegalFormAuditFirm:                 {"Audit Corporation", "", true},
+	LegalFormJudicialScrivener:         {"Judicial Scrivener Corporation", "", true},
+	LegalFormAdministrativeScrivener:   {"Administrative Scrivener Corporation", "", true},
+	LegalFormLaborConsultant:           {"Labor and Social Security Attorney Corporation", "", true},
+	LegalFormPatentFirm:                {"Patent Professional Corporation", "", true},
+	LegalFormCooperative:               {"Cooperative", "", false},
+	LegalFormEnterpriseAssociation:     {"Enterprise Association", "", false},
+	LegalFormAgricultural:              {"Agricultural Cooperative Corporation", "", false},
+	LegalFormShinkin:                   {"Shinkin Bank", "", false},
+	LegalFormManagementAssociation:     {"Management Association Corporation", "", false},
+}
+
+/*
+English は法人格の英語表記を返します。
+
+	LegalFormStockCompany.English(EnglishStyleConventional) // Co., Ltd.
+	LegalFormStockCompany.English(EnglishStyleRomanized)    // K.K.
+
+法人格でない場合は空文字を返します。
+*/
+func (f LegalForm) English(style EnglishStyle) string {
+	e, ok := legalFormEnglishes[f]
+	if !ok {
+		return ""
+	}
+	if style == EnglishStyleRomanized && e.romanized != "" {
+		return e.romanized
+	}
+	return e.conventional
+}
+
+// EnglishName は英字の名称です。
+type EnglishName struct {
+	Name string
+	// 英語表記(EnName)ではなくフリガナ等から生成した名称の場合 true
+	Generated bool
+}
+
+// String は名称を返します。
+func (n EnglishName) String() string {
+	return n.Name
+}
+
+/*
+EnglishName は英字の名称を返します。
+
+英語表記(EnName)が登録されている場合はその名称を, 登録されていない場合は
+RomanizedName(EnglishStyleConventional) で生成した名称を Generated を true にして返します。
+生成できない場合は空の EnglishName を返します。
+*/
+func (c Corporation) EnglishName() EnglishName {
+	if c.EnName != "" {
+		return EnglishName{Name: c.EnName}
+	}
+	if name := c.RomanizedName(EnglishStyleConventional); name != "" {
+		return EnglishName{Name: name, Generated: true}
+	}
+	return EnglishName{}
+}
+
+/*
+RomanizedName はフリガナをヘボン式のローマ字にし, 法人格の英語表記を加えた名称を返します。
+
+	// 商号又は名称: 株式会社フィルイン, フリガナ: フィルイン
+	c.RomanizedName(EnglishStyleConventional) // Firuin Co., Ltd.
+	c.RomanizedName(EnglishStyleRomanized)    // Firuin K.K.
+
+フリガナは法人格を除いた読みとして扱います。
+フリガナがない場合は法人格を除いた名称がカナと英数字のみであれば名称から生成し,
+それ以外の場合は空文字を返します。
+*/
+func (c Corporation) RomanizedName(style EnglishStyle) string {
+	p := c.ParsedName()
+	kana := c.Furigana
+	if kana == "" && romanizable(p.Core) {
+		kana = p.Core
+	}
+	name := romaji.Title(kana)
+	if name == "" {
+		return ""
+	}
+
+	e, ok := legalFormEnglishes[p.LegalForm]
+	if !ok {
+		return name
+	}
+	if e.prefix {
+		return p.LegalForm.English(style) + " " + name
+	}
+	return name + " " + p.LegalForm.English(style)
+}
+
+// romanizable はカナ, 英数字, 空白, 長音記号, 中黒のみの文字列か判定します。
+func romanizable(s string) bool {
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Katakana, unicode.Hiragana):
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+		case unicode.IsSpace(r), strings.ContainsRune("ー・", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package corp
+
+import "testing"
+
+func TestLegalFormEnglish(t *testing.T) {
+	tests := []struct {
+		form     LegalForm
+		style    EnglishStyle
+		expected string
+	}{
+		{LegalFormStockCompany, EnglishStyleConventional, "Co., Ltd."},
+		{LegalFormStockCompany, EnglishStyleRomanized, "K.K."},
+		{LegalFormLLC, EnglishStyleRomanized, "G.K."},
+		{LegalFormMedical, EnglishStyleRomanized, "Medical Corporation"},
+		{LegalForm("株式会"), EnglishStyleConventional, ""},
+	}
+	for _, test := range tests {
+		if result := test.form.English(test.style); result != test.expected {
+			t.Errorf("%s: result:%s expected:%s", test.form, result, test.expected)
+		}
+	}
+
+	for _, spec := range legalForms {
+		if spec.form.English(EnglishStyleConventional) == "" {
+			t.Errorf("%s: English is empty", spec.form)
+		}
+	}
+}
+
+func TestEnglishName(t *testing.T) {
+	res := testResponse(t, "./testdata/response/by_numbers.xml")
+	fillin, gunma := res.Corporations[0], res.Corporations[1]
+
+	if result := fillin.EnglishName(); result != (EnglishName{"Firuin Co., Ltd.", true}) {
+		t.Errorf("generated name is wrong. result:%v", result)
+	}
+	if result := gunma.EnglishName(); result != (EnglishName{"Gunma Prefectural Government", false}) {
+		t.Errorf("registered name is wrong. result:%v", result)
+	}
+	if result := (Corporation{Name: "国税庁"}).EnglishName(); result != (EnglishName{}) {
+		t.Errorf("name is generated. result:%v", result)
+	}
+	if result := (Corporation{Name: "株式会社Woodstock"}).EnglishName(); result != (EnglishName{"Woodstock Co., Ltd.", true}) {
+		t.Errorf("generated name is wrong. result:%v", result)
+	}
+	if result := fillin.EnglishName().String(); result != "Firuin Co., Ltd." {
+		t.Errorf("String is wrong. result:%s", result)
+	}
+}
+
+func TestRomanizedName(t *testing.T) {
+	tests := []struct {
+		c        Corporation
+		style    EnglishStyle
+		expected string
+	}{
+		{Corporation{Name: "株式会社フィルイン", Furigana: "フィルイン"}, EnglishStyleRomanized, "Firuin K.K."},
+		{Corporation{Name: "高崎信用金庫", Furigana: "タカサキ"}, EnglishStyleConventional, "Takasaki Shinkin Bank"},
+		{Corporation{Name: "医療法人社団健康会", Furigana: "ケンコウカイ"}, EnglishStyleConventional, "Medical Corporation Kenkokai"},
+		{Corporation{Name: "新橋商事合同会社", Furigana: "シンバシショウジ"}, EnglishStyleConventional, "Shimbashishoji LLC"},
+		{Corporation{Name: "ハッチョウ有限会社"}, EnglishStyleConventional, "Hatcho Ltd."},
+		{Corporation{Name: "ABCカンパニー株式会社"}, EnglishStyleConventional, "ABCkampani Co., Ltd."},
+		{Corporation{Name: "株式会社高崎"}, EnglishStyleConventional, ""},
+		{Corporation{Name: "株式会社Good Food"}, EnglishStyleConventional, "Good Food Co., Ltd."},
+		{Corporation{Name: "Woodstockコーポ株式会社"}, EnglishStyleConventional, "Woodstockkopo Co., Ltd."},
+		{Corporation{Name: "群馬県", Furigana: "グンマケン"}, EnglishStyleConventional, "Gummaken"},
+	}
+	for _, test := range tests {
+		if result := test.c.RomanizedName(test.style); result != test.expected {
+			t.Errorf("%s: result:%s expected:%s", test.c.Name, result, test.expected)
+		}
+	}
+}
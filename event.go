@@ -0,0 +1,269 @@
+package corp
+
+import "fmt"
+
+// EventType は法人情報の変更イベントの種類です。
+type EventType string
+
+const (
+	// 新規
+	EventNewlyAssigned EventType = "NewlyAssigned"
+	// 商号又は名称の変更
+	EventNameChanged EventType = "NameChanged"
+	// 国内所在地の変更
+	EventDomesticAddressChanged EventType = "DomesticAddressChanged"
+	// 国外所在地の変更
+	EventForeignAddressChanged EventType = "ForeignAddressChanged"
+	// 登記記録の閉鎖等
+	EventRegistrationClosed EventType = "RegistrationClosed"
+	// 登記記録の復活等
+	EventRegistrationRestored EventType = "RegistrationRestored"
+	// 吸収合併
+	EventAbsorbedByMerger EventType = "AbsorbedByMerger"
+	// 吸収合併無効
+	EventMergerNullified EventType = "MergerNullified"
+	// 商号の登記の抹消
+	EventTradeNameErased EventType = "TradeNameErased"
+	// 削除
+	EventDeleted EventType = "Deleted"
+)
+
+// 処理区分とイベントの種類の対応
+var processEvents = map[Process]EventType{
+	ProcessNew:                    EventNewlyAssigned,
+	ProcessNameChanged:            EventNameChanged,
+	ProcessDomesticAddressChanged: EventDomesticAddressChanged,
+	ProcessForeignAddressChanged:  EventForeignAddressChanged,
+	ProcessClosed:                 EventRegistrationClosed,
+	ProcessRestored:               EventRegistrationRestored,
+	ProcessAbsorbed:               EventAbsorbedByMerger,
+	ProcessMergerNullified:        EventMergerNullified,
+	ProcessTradeNameErased:        EventTradeNameErased,
+	ProcessDeleted:                EventDeleted,
+}
+
+/*
+ChangeEvent は処理区分(Process)から変換された法人情報の変更イベントです。
+
+具体的な型は NameChanged や RegistrationClosed などのイベント構造体です。
+型スイッチで判別してください。
+*/
+type ChangeEvent interface {
+	// Header はイベント共通の情報を返します。
+	Header() EventHeader
+}
+
+// EventHeader はイベント共通の情報です。
+type EventHeader struct {
+	// イベントの種類
+	Type EventType `json:"type"`
+	// 法人番号
+	CorporateNumber uint64 `json:"corporate_number"`
+	// 処理区分
+	Process Process `json:"process"`
+	// 訂正区分
+	Correct bool `json:"correct"`
+	// 更新年月日
+	UpdateDate *Date `json:"update_date,omitempty"`
+	// 変更年月日
+	ChangeDate *Date `json:"change_date,omitempty"`
+	// 変更事由の詳細
+	ChangeCause string `json:"change_cause,omitempty"`
+}
+
+// Header はイベント共通の情報を返します。
+func (h EventHeader) Header() EventHeader {
+	return h
+}
+
+// DomesticAddress は国内所在地です。
+type DomesticAddress struct {
+	// 国内所在地(都道府県)
+	PrefectureName string `json:"prefecture_name"`
+	// 国内所在地(市区町村)
+	CityName string `json:"city_name"`
+	// 国内所在地(丁目番地等)
+	StreetNumber string `json:"street_number"`
+	// 都道府県コード
+	PrefectureCode uint8 `json:"prefecture_code"`
+	// 市区町村コード
+	CityCode uint16 `json:"city_code"`
+	// 郵便番号
+	PostCode string `json:"post_code"`
+}
+
+// NewlyAssigned は法人番号の新規指定イベントです。
+type NewlyAssigned struct {
+	EventHeader
+	// 商号または名称
+	Name string `json:"name"`
+	// 法人種別
+	Kind Kind `json:"kind"`
+	// 法人番号指定年月日
+	AssignmentDate *Date `json:"assignment_date,omitempty"`
+}
+
+// NameChanged は商号又は名称の変更イベントです。
+type NameChanged struct {
+	EventHeader
+	// 変更前の商号または名称
+	// 変更前の法人情報が不明な場合は空文字
+	OldName string `json:"old_name,omitempty"`
+	// 変更後の商号または名称
+	Name string `json:"name"`
+	// 変更後のフリガナ
+	Furigana string `json:"furigana,omitempty"`
+}
+
+// DomesticAddressChanged は国内所在地の変更イベントです。
+type DomesticAddressChanged struct {
+	EventHeader
+	// 変更前の国内所在地
+	// 変更前の法人情報が不明な場合は nil
+	Old *DomesticAddress `json:"old,omitempty"`
+	// 変更後の国内所在地
+	New DomesticAddress `json:"new"`
+}
+
+// ForeignAddressChanged は国外所在地の変更イベントです。
+type ForeignAddressChanged struct {
+	EventHeader
+	// 変更前の国外所在地
+	// 変更前の法人情報が不明な場合は空文字
+	OldAddressOutside string `json:"old_address_outside,omitempty"`
+	// 変更後の国外所在地
+	AddressOutside string `json:"address_outside"`
+}
+
+// RegistrationClosed は登記記録の閉鎖等のイベントです。
+type RegistrationClosed struct {
+	EventHeader
+	// 登記記録の閉鎖等年月日
+	CloseDate *Date `json:"close_date,omitempty"`
+	// 登記記録の閉鎖等の事由
+	CloseCause CloseCause `json:"close_cause,omitempty"`
+	// 承継先法人番号
+	SuccessorCorporateNumber uint64 `json:"successor_corporate_number,omitempty"`
+}
+
+// RegistrationRestored は登記記録の復活等のイベントです。
+type RegistrationRestored struct {
+	EventHeader
+}
+
+// AbsorbedByMerger は吸収合併による消滅イベントです。
+type AbsorbedByMerger struct {
+	EventHeader
+	// 登記記録の閉鎖等年月日
+	CloseDate *Date `json:"close_date,omitempty"`
+	// 登記記録の閉鎖等の事由
+	CloseCause CloseCause `json:"close_cause,omitempty"`
+	// 承継先法人番号
+	SuccessorCorporateNumber uint64 `json:"successor_corporate_number,omitempty"`
+}
+
+// MergerNullified は吸収合併無効のイベントです。
+type MergerNullified struct {
+	EventHeader
+	// 承継先法人番号
+	// 変更前の法人情報から取得できる場合のみ設定
+	SuccessorCorporateNumber uint64 `json:"successor_corporate_number,omitempty"`
+}
+
+// TradeNameErased は商号の登記の抹消イベントです。
+type TradeNameErased struct {
+	EventHeader
+	// 抹消された商号
+	// 変更前の法人情報が不明な場合は空文字
+	OldName string `json:"old_name,omitempty"`
+}
+
+// Deleted は削除イベントです。
+type Deleted struct {
+	EventHeader
+}
+
+/*
+EventOf は法人情報の処理区分(Process)を変更イベントに変換します。
+
+prev には変更前の法人情報を指定します。
+指定した場合は変更前の商号や所在地がイベントに設定されます。不明な場合は nil を指定してください。
+
+未知の処理区分の場合はエラーを返します。
+*/
+func EventOf(c Corporation, prev *Corporation) (ChangeEvent, error) {
+	typ, ok := processEvents[c.Process]
+	if !ok {
+		return nil, fmt.Errorf("unknown process code: %q", string(c.Process))
+	}
+
+	h := EventHeader{
+		Type:            typ,
+		CorporateNumber: c.CorporateNumber,
+		Process:         c.Process,
+		Correct:         c.Correct,
+		UpdateDate:      c.UpdateDate,
+		ChangeDate:      c.ChangeDate,
+		ChangeCause:     c.ChangeCause,
+	}
+
+	switch typ {
+	case EventNewlyAssigned:
+		return NewlyAssigned{h, c.Name, c.Kind, c.AssignmentDate}, nil
+	case EventNameChanged:
+		e := NameChanged{EventHeader: h, Name: c.Name, Furigana: c.Furigana}
+		if prev != nil {
+			e.OldName = prev.Name
+		}
+		return e, nil
+	case EventDomesticAddressChanged:
+		e := DomesticAddressChanged{EventHeader: h, New: domesticAddress(c)}
+		if prev != nil {
+			old := domesticAddress(*prev)
+			e.Old = &old
+		}
+		return e, nil
+	case EventForeignAddressChanged:
+		e := ForeignAddressChanged{EventHeader: h, AddressOutside: c.AddressOutside}
+		if prev != nil {
+			e.OldAddressOutside = prev.AddressOutside
+		}
+		return e, nil
+	case EventRegistrationClosed:
+		return RegistrationClosed{h, c.CloseDate, c.CloseCause, c.SuccessorCorporateNumber}, nil
+	case EventRegistrationRestored:
+		return RegistrationRestored{h}, nil
+	case EventAbsorbedByMerger:
+		return AbsorbedByMerger{h, c.CloseDate, c.CloseCause, c.SuccessorCorporateNumber}, nil
+	case EventMergerNullified:
+		e := MergerNullified{EventHeader: h}
+		if prev != nil {
+			e.SuccessorCorporateNumber = prev.SuccessorCorporateNumber
+		}
+		return e, nil
+	case EventTradeNameErased:
+		e := TradeNameErased{EventHeader: h}
+		if prev != nil {
+			e.OldName = prev.Name
+		}
+		return e, nil
+	default:
+		return Deleted{h}, nil
+	}
+}
+
+// Event は監視で検知した変更を変更イベントに変換します。
+func (e WatchEvent) Event() (ChangeEvent, error) {
+	return EventOf(e.New, e.Old)
+}
+
+func domesticAddress(c Corporation) DomesticAddress {
+	return DomesticAddress{
+		PrefectureName: c.PrefectureName,
+		CityName:       c.CityName,
+		StreetNumber:   c.StreetNumber,
+		PrefectureCode: c.PrefectureCode,
+		CityCode:       c.CityCode,
+		PostCode:       c.PostCode,
+	}
+}
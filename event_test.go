@@ -0,0 +1,139 @@
+package corp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEventOf(t *testing.T) {
+	changeDate := Date(time.Date(2021, 6, 2, 0, 0, 0, 0, currentLocation()))
+	closeDate := Date(time.Date(2022, 4, 1, 0, 0, 0, 0, currentLocation()))
+
+	t.Run("Event Types", func(t *testing.T) {
+		tests := []struct {
+			process  Process
+			expected EventType
+		}{
+			{"01", EventNewlyAssigned},
+			{"11", EventNameChanged},
+			{"12", EventDomesticAddressChanged},
+			{"13", EventForeignAddressChanged},
+			{"21", EventRegistrationClosed},
+			{"22", EventRegistrationRestored},
+			{"71", EventAbsorbedByMerger},
+			{"72", EventMergerNullified},
+			{"81", EventTradeNameErased},
+			{"99", EventDeleted},
+		}
+
+		for _, test := range tests {
+			e, err := EventOf(Corporation{CorporateNumber: testFillinCorpNum, Process: test.process}, nil)
+			if err != nil {
+				t.Errorf("%s: error! %v", test.process, err)
+				continue
+			}
+
+			h := e.Header()
+			if h.Type != test.expected {
+				t.Errorf("%s: Type is wrong. result:%s expected:%s", test.process, h.Type, test.expected)
+			}
+
+			if h.CorporateNumber != testFillinCorpNum {
+				t.Errorf("%s: CorporateNumber is wrong. result:%d", test.process, h.CorporateNumber)
+			}
+		}
+	})
+
+	t.Run("Unknown Process", func(t *testing.T) {
+		if _, err := EventOf(Corporation{Process: "00"}, nil); err == nil {
+			t.Error("No error occurred.")
+		}
+	})
+
+	t.Run("NameChanged", func(t *testing.T) {
+		prev := Corporation{Name: "有限会社フィルイン"}
+		c := Corporation{Process: "11", Name: "株式会社フィルイン", ChangeDate: &changeDate, ChangeCause: "組織変更"}
+		e, _ := EventOf(c, &prev)
+
+		nc, ok := e.(NameChanged)
+		if !ok {
+			t.Fatalf("event type is wrong. result:%T", e)
+		}
+
+		if nc.OldName != prev.Name || nc.Name != c.Name {
+			t.Errorf("names are wrong. result:%s -> %s", nc.OldName, nc.Name)
+		}
+
+		if nc.ChangeDate.String() != "2021-06-02" || nc.ChangeCause != "組織変更" {
+			t.Errorf("header is wrong. result:%+v", nc.EventHeader)
+		}
+	})
+
+	t.Run("DomesticAddressChanged", func(t *testing.T) {
+		prev := Corporation{PrefectureName: "群馬県", CityName: "高崎市", StreetNumber: "本町４８番地", PostCode: "3700813"}
+		c := Corporation{Process: "12", PrefectureName: "群馬県", CityName: "高崎市", StreetNumber: "飯塚町１４７番地４", PostCode: "3700069"}
+
+		e, _ := EventOf(c, nil)
+		if e.(DomesticAddressChanged).Old != nil {
+			t.Error("Old is not nil without previous corporation.")
+		}
+
+		e, _ = EventOf(c, &prev)
+		ac := e.(DomesticAddressChanged)
+		if ac.Old == nil || ac.Old.StreetNumber != prev.StreetNumber {
+			t.Errorf("Old is wrong. result:%+v", ac.Old)
+		}
+
+		if ac.New.StreetNumber != c.StreetNumber || ac.New.PostCode != c.PostCode {
+			t.Errorf("New is wrong. result:%+v", ac.New)
+		}
+	})
+
+	t.Run("AbsorbedByMerger", func(t *testing.T) {
+		c := Corporation{Process: "71", CloseDate: &closeDate, CloseCause: "11", SuccessorCorporateNumber: testGunmaCorpNum}
+		e, _ := EventOf(c, nil)
+
+		ab, ok := e.(AbsorbedByMerger)
+		if !ok {
+			t.Fatalf("event type is wrong. result:%T", e)
+		}
+
+		if ab.CloseCause != "11" || ab.SuccessorCorporateNumber != testGunmaCorpNum || ab.CloseDate.String() != "2022-04-01" {
+			t.Errorf("event is wrong. result:%+v", ab)
+		}
+	})
+}
+
+func TestEventMarshalJSON(t *testing.T) {
+	changeDate := Date(time.Date(2021, 6, 2, 0, 0, 0, 0, currentLocation()))
+	c := Corporation{CorporateNumber: testFillinCorpNum, Process: "11", Name: "株式会社フィルイン", ChangeDate: &changeDate}
+	e, _ := EventOf(c, &Corporation{Name: "有限会社フィルイン"})
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	expected := `{"type":"NameChanged","corporate_number":5070001032626,"process":"11","correct":false,"change_date":"2021-06-02","old_name":"有限会社フィルイン","name":"株式会社フィルイン"}`
+	if string(b) != expected {
+		t.Errorf("JSON is wrong.\nresult:  %s\nexpected:%s", b, expected)
+	}
+}
+
+func TestWatchEventEvent(t *testing.T) {
+	e := WatchEvent{
+		CorporateNumber: testFillinCorpNum,
+		Old:             &Corporation{StreetNumber: "本町４８番地"},
+		New:             Corporation{Process: "12", StreetNumber: "飯塚町１４７番地４"},
+	}
+
+	ce, err := e.Event()
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+
+	if ce.(DomesticAddressChanged).Old.StreetNumber != "本町４８番地" {
+		t.Errorf("Old is wrong. result:%+v", ce)
+	}
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+// UTF-8 の BOM
+var bom = []byte{0xEF, 0xBB, 0xBF}
+
+/*
+Writer は法人情報を CSV または TSV で書き込みます。
+
+BOM と見出し行は最初の書き込みまたは Flush の際に出力されます。
+書き込んだデータは内部でバッファされるため, 最後に Flush を呼び出してください。
+*/
+type Writer struct {
+	w       io.Writer
+	csv     *csv.Writer
+	columns []Column
+	locale  corp.Locale
+	bom     bool
+	header  bool
+	started bool
+	record  []string
+}
+
+// NewCSVWriter は CSV を書き込む Writer を生成します。出力できない列が指定された場合はエラーを返します。
+func NewCSVWriter(w io.Writer, opts Options) (*Writer, error) {
+	return newWriter(w, ',', opts)
+}
+
+// NewTSVWriter は TSV を書き込む Writer を生成します。出力できない列が指定された場合はエラーを返します。
+func NewTSVWriter(w io.Writer, opts Options) (*Writer, error) {
+	return newWriter(w, '\t', opts)
+}
+
+func newWriter(w io.Writer, comma rune, opts Options) (*Writer, error) {
+	columns, err := opts.columns()
+	if err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	return &Writer{
+		w:       w,
+		csv:     cw,
+		columns: columns,
+		locale:  opts.locale(),
+		bom:     opts.BOM,
+		header:  !opts.NoHeader,
+		record:  make([]string, len(columns)),
+	}, nil
+}
+
+// Write は法人情報を 1 行書き込みます。
+func (w *Writer) Write(c corp.Corporation) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	for i, col := range w.columns {
+		w.record[i] = col.Value(c, w.locale)
+	}
+	return w.csv.Write(w.record)
+}
+
+// WriteResponse はレスポンスに含まれる法人情報を順に書き込みます。
+func (w *Writer) WriteResponse(res corp.Response) error {
+	for _, c := range res.Corporations {
+		if err := w.Write(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush はバッファに残っているデータを書き込みます。法人情報を書き込んでいない場合も BOM と見出し行は出力されます。
+func (w *Writer) Flush() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	if w.bom {
+		if _, err := w.w.Write(bom); err != nil {
+			return err
+		}
+	}
+
+	if !w.header {
+		return nil
+	}
+	for i, col := range w.columns {
+		w.record[i] = col.Header(w.locale)
+	}
+	return w.csv.Write(w.record)
+}
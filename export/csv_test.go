@@ -0,0 +1,121 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"os"
+	"testing"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+func TestCSVWriter(t *testing.T) {
+	res := testResponse(t)
+
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, Options{
+		Columns: []Column{ColumnCorporateNumber, ColumnName, ColumnKind, ColumnKindLabel, ColumnPrefectureCode, ColumnCloseDate, ColumnLatest},
+		Locale:  corp.LocaleJa,
+	})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+
+	if err := w.WriteResponse(res); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	expected := "法人番号,商号又は名称,法人種別,法人種別(名称),都道府県コード,登記記録の閉鎖等年月日,最新履歴\n" +
+		"5070001032626,株式会社フィルイン,301,株式会社,10,,1\n" +
+		"7000020100005,群馬県,201,地方公共団体,10,,1\n"
+	if buf.String() != expected {
+		t.Errorf("CSV is wrong.\nresult:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestTSVWriterWithBOM(t *testing.T) {
+	res := testResponse(t)
+
+	var buf bytes.Buffer
+	w, err := NewTSVWriter(&buf, Options{
+		Columns: []Column{ColumnCorporateNumber, ColumnProcess, ColumnProcessLabel, ColumnUpdateDate},
+		Locale:  corp.LocaleEn,
+		BOM:     true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+
+	for _, c := range res.Corporations {
+		if err := w.Write(c); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	expected := "\xEF\xBB\xBFCorporate Number\tProcess\tProcess Name\tUpdate Date\n" +
+		"5070001032626\t12\tChange of domestic address\t2021-06-09\n" +
+		"7000020100005\t01\tNew\t2018-04-03\n"
+	if buf.String() != expected {
+		t.Errorf("TSV is wrong.\nresult:\n%q\nexpected:\n%q", buf.String(), expected)
+	}
+}
+
+func TestWriterHeaderOnly(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewCSVWriter(&buf, Options{Columns: []Column{ColumnName, ColumnFurigana}, Locale: corp.LocaleEn})
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+	if buf.String() != "Name,Furigana\n" {
+		t.Errorf("header is wrong. result:%q", buf.String())
+	}
+
+	buf.Reset()
+	w, _ = NewCSVWriter(&buf, Options{Columns: []Column{ColumnName}, NoHeader: true})
+	_ = w.Write(corp.Corporation{Name: "株式会社\"テスト\",本店"})
+	_ = w.Flush()
+	if buf.String() != "\"株式会社\"\"テスト\"\",本店\"\n" {
+		t.Errorf("CSV is wrong. result:%q", buf.String())
+	}
+}
+
+func TestWriterUnknownColumn(t *testing.T) {
+	if _, err := NewCSVWriter(&bytes.Buffer{}, Options{Columns: []Column{ColumnName, "Unknown"}}); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+func TestWriterError(t *testing.T) {
+	w, _ := NewCSVWriter(errWriter{}, Options{BOM: true})
+	if err := w.Write(corp.Corporation{}); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write error")
+}
+
+func testResponse(t *testing.T) corp.Response {
+	t.Helper()
+
+	data, err := os.ReadFile("../testdata/response/by_numbers.xml")
+	if err != nil {
+		t.Fatalf("failed to read XML: %v", err)
+	}
+
+	var res corp.Response
+	if err := xml.Unmarshal(data, &res); err != nil {
+		t.Fatalf("failed to parse XML: %v", err)
+	}
+	return res
+}
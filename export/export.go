@@ -0,0 +1,262 @@
+/*
+法人情報をファイルに出力するパッケージです。
+
+Response または Corporation を 1 件ずつ CSV, TSV, NDJSON(改行区切りの JSON), XLSX で io.Writer に書き込みます。
+書き込みは 1 件ごとに行われるため, 期間指定検索の分割取得のように
+大量の法人情報を取得しながら出力する場合でもすべてをメモリに保持する必要はありません。
+
+	w, err := export.NewCSVWriter(f, export.Options{Locale: corp.LocaleEn, BOM: true})
+	if err != nil {
+		return err
+	}
+	for _, number := range numbers {
+		res, err := corp.ByNumberWithHistory(number)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteResponse(res); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+*/
+package export
+
+import (
+	"fmt"
+	"strconv"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+// Encoder は法人情報を 1 件ずつ書き込むエンコーダです。
+type Encoder interface {
+	// Write は法人情報を 1 件書き込みます。
+	Write(c corp.Corporation) error
+	// WriteResponse はレスポンスに含まれる法人情報を順に書き込みます。
+	WriteResponse(res corp.Response) error
+	// Flush はバッファに残っているデータを書き込みます。
+	Flush() error
+}
+
+/*
+Column は CSV, TSV, XLSX に出力する列です。
+
+列名は Corporation のフィールド名と同じです。
+ProcessLabel, KindLabel, CloseCauseLabel はコード値の表示用テキストを出力します。
+*/
+type Column string
+
+const (
+	ColumnSequenceNumber           Column = "SequenceNumber"
+	ColumnCorporateNumber          Column = "CorporateNumber"
+	ColumnProcess                  Column = "Process"
+	ColumnProcessLabel             Column = "ProcessLabel"
+	ColumnCorrect                  Column = "Correct"
+	ColumnUpdateDate               Column = "UpdateDate"
+	ColumnChangeDate               Column = "ChangeDate"
+	ColumnName                     Column = "Name"
+	ColumnNameImageId              Column = "NameImageId"
+	ColumnKind                     Column = "Kind"
+	ColumnKindLabel                Column = "KindLabel"
+	ColumnPrefectureName           Column = "PrefectureName"
+	ColumnCityName                 Column = "CityName"
+	ColumnStreetNumber             Column = "StreetNumber"
+	ColumnAddressImageId           Column = "AddressImageId"
+	ColumnPrefectureCode           Column = "PrefectureCode"
+	ColumnCityCode                 Column = "CityCode"
+	ColumnPostCode                 Column = "PostCode"
+	ColumnAddressOutside           Column = "AddressOutside"
+	ColumnAddressOutsideImageId    Column = "AddressOutsideImageId"
+	ColumnCloseDate                Column = "CloseDate"
+	ColumnCloseCause               Column = "CloseCause"
+	ColumnCloseCauseLabel          Column = "CloseCauseLabel"
+	ColumnSuccessorCorporateNumber Column = "SuccessorCorporateNumber"
+	ColumnChangeCause              Column = "ChangeCause"
+	ColumnAssignmentDate           Column = "AssignmentDate"
+	ColumnLatest                   Column = "Latest"
+	ColumnEnName                   Column = "EnName"
+	ColumnEnPrefectureName         Column = "EnPrefectureName"
+	ColumnEnCityName               Column = "EnCityName"
+	ColumnEnAddressOutside         Column = "EnAddressOutside"
+	ColumnFurigana                 Column = "Furigana"
+	ColumnHihyoji                  Column = "Hihyoji"
+)
+
+// AllColumns は出力できるすべての列です。Web-API のリソース定義書の順に並んでいます。
+var AllColumns = []Column{
+	ColumnSequenceNumber,
+	ColumnCorporateNumber,
+	ColumnProcess,
+	ColumnProcessLabel,
+	ColumnCorrect,
+	ColumnUpdateDate,
+	ColumnChangeDate,
+	ColumnName,
+	ColumnNameImageId,
+	ColumnKind,
+	ColumnKindLabel,
+	ColumnPrefectureName,
+	ColumnCityName,
+	ColumnStreetNumber,
+	ColumnAddressImageId,
+	ColumnPrefectureCode,
+	ColumnCityCode,
+	ColumnPostCode,
+	ColumnAddressOutside,
+	ColumnAddressOutsideImageId,
+	ColumnCloseDate,
+	ColumnCloseCause,
+	ColumnCloseCauseLabel,
+	ColumnSuccessorCorporateNumber,
+	ColumnChangeCause,
+	ColumnAssignmentDate,
+	ColumnLatest,
+	ColumnEnName,
+	ColumnEnPrefectureName,
+	ColumnEnCityName,
+	ColumnEnAddressOutside,
+	ColumnFurigana,
+	ColumnHihyoji,
+}
+
+// DefaultColumns は Options.Columns を指定しない場合に出力する列です。
+var DefaultColumns = []Column{
+	ColumnCorporateNumber,
+	ColumnProcess,
+	ColumnProcessLabel,
+	ColumnCorrect,
+	ColumnUpdateDate,
+	ColumnChangeDate,
+	ColumnName,
+	ColumnFurigana,
+	ColumnKind,
+	ColumnKindLabel,
+	ColumnPrefectureName,
+	ColumnCityName,
+	ColumnStreetNumber,
+	ColumnPostCode,
+	ColumnCloseDate,
+	ColumnCloseCause,
+	ColumnSuccessorCorporateNumber,
+	ColumnAssignmentDate,
+	ColumnLatest,
+}
+
+// columnValues は列ごとの値の取得方法です。表示用テキストの列は言語を参照します。
+var columnValues = map[Column]func(c corp.Corporation, l corp.Locale) string{
+	ColumnSequenceNumber: func(c corp.Corporation, _ corp.Locale) string {
+		return strconv.FormatUint(uint64(c.SequenceNumber), 10)
+	},
+	ColumnCorporateNumber: func(c corp.Corporation, _ corp.Locale) string { return padded(c.CorporateNumber, 13) },
+	ColumnProcess:         func(c corp.Corporation, _ corp.Locale) string { return string(c.Process) },
+	ColumnProcessLabel:    func(c corp.Corporation, l corp.Locale) string { return c.Process.Label(l) },
+	ColumnCorrect:         func(c corp.Corporation, _ corp.Locale) string { return flag(c.Correct) },
+	ColumnUpdateDate:      func(c corp.Corporation, _ corp.Locale) string { return date(c.UpdateDate) },
+	ColumnChangeDate:      func(c corp.Corporation, _ corp.Locale) string { return date(c.ChangeDate) },
+	ColumnName:            func(c corp.Corporation, _ corp.Locale) string { return c.Name },
+	ColumnNameImageId:     func(c corp.Corporation, _ corp.Locale) string { return c.NameImageId },
+	ColumnKind: func(c corp.Corporation, _ corp.Locale) string {
+		if c.Kind == 0 {
+			return ""
+		}
+		return strconv.FormatUint(uint64(c.Kind), 10)
+	},
+	ColumnKindLabel:                func(c corp.Corporation, l corp.Locale) string { return c.Kind.Label(l) },
+	ColumnPrefectureName:           func(c corp.Corporation, _ corp.Locale) string { return c.PrefectureName },
+	ColumnCityName:                 func(c corp.Corporation, _ corp.Locale) string { return c.CityName },
+	ColumnStreetNumber:             func(c corp.Corporation, _ corp.Locale) string { return c.StreetNumber },
+	ColumnAddressImageId:           func(c corp.Corporation, _ corp.Locale) string { return c.AddressImageId },
+	ColumnPrefectureCode:           func(c corp.Corporation, _ corp.Locale) string { return padded(uint64(c.PrefectureCode), 2) },
+	ColumnCityCode:                 func(c corp.Corporation, _ corp.Locale) string { return padded(uint64(c.CityCode), 3) },
+	ColumnPostCode:                 func(c corp.Corporation, _ corp.Locale) string { return c.PostCode },
+	ColumnAddressOutside:           func(c corp.Corporation, _ corp.Locale) string { return c.AddressOutside },
+	ColumnAddressOutsideImageId:    func(c corp.Corporation, _ corp.Locale) string { return c.AddressOutsideImageId },
+	ColumnCloseDate:                func(c corp.Corporation, _ corp.Locale) string { return date(c.CloseDate) },
+	ColumnCloseCause:               func(c corp.Corporation, _ corp.Locale) string { return string(c.CloseCause) },
+	ColumnCloseCauseLabel:          func(c corp.Corporation, l corp.Locale) string { return c.CloseCause.Label(l) },
+	ColumnSuccessorCorporateNumber: func(c corp.Corporation, _ corp.Locale) string { return padded(c.SuccessorCorporateNumber, 13) },
+	ColumnChangeCause:              func(c corp.Corporation, _ corp.Locale) string { return c.ChangeCause },
+	ColumnAssignmentDate:           func(c corp.Corporation, _ corp.Locale) string { return date(c.AssignmentDate) },
+	ColumnLatest:                   func(c corp.Corporation, _ corp.Locale) string { return flag(c.Latest) },
+	ColumnEnName:                   func(c corp.Corporation, _ corp.Locale) string { return c.EnName },
+	ColumnEnPrefectureName:         func(c corp.Corporation, _ corp.Locale) string { return c.EnPrefectureName },
+	ColumnEnCityName:               func(c corp.Corporation, _ corp.Locale) string { return c.EnCityName },
+	ColumnEnAddressOutside:         func(c corp.Corporation, _ corp.Locale) string { return c.EnAddressOutside },
+	ColumnFurigana:                 func(c corp.Corporation, _ corp.Locale) string { return c.Furigana },
+	ColumnHihyoji:                  func(c corp.Corporation, _ corp.Locale) string { return flag(c.Hihyoji) },
+}
+
+// Header は列の見出しを指定した言語で返します。
+func (col Column) Header(l corp.Locale) string {
+	return corp.FieldLabel(l, string(col))
+}
+
+// Value は法人情報の列の値を返します。表示用テキストの列は指定した言語で返します。
+func (col Column) Value(c corp.Corporation, l corp.Locale) string {
+	if f, ok := columnValues[col]; ok {
+		return f(c, l)
+	}
+	return ""
+}
+
+// IsValid は出力できる列か判定します。
+func (col Column) IsValid() bool {
+	_, ok := columnValues[col]
+	return ok
+}
+
+// Options は CSV, TSV, XLSX の出力の設定です。
+type Options struct {
+	// 出力する列
+	// 未指定の場合は DefaultColumns
+	Columns []Column
+	// 見出しと表示用テキストの言語
+	// 未指定の場合は corp.CurrentLocale()
+	Locale corp.Locale
+	// 先頭に UTF-8 の BOM を出力するか
+	// Excel で開く場合に指定します
+	BOM bool
+	// 見出し行を出力しないか
+	NoHeader bool
+}
+
+func (o Options) columns() ([]Column, error) {
+	if len(o.Columns) == 0 {
+		return DefaultColumns, nil
+	}
+	for _, col := range o.Columns {
+		if !col.IsValid() {
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return o.Columns, nil
+}
+
+func (o Options) locale() corp.Locale {
+	if o.Locale == "" {
+		return corp.CurrentLocale()
+	}
+	return o.Locale
+}
+
+func padded(n uint64, digits int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%0*d", digits, n)
+}
+
+func flag(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func date(d *corp.Date) string {
+	if d == nil || d.Time().IsZero() {
+		return ""
+	}
+	return d.String()
+}
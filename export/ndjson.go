@@ -0,0 +1,53 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+/*
+NDJSONWriter は法人情報を改行区切りの JSON(NDJSON)で書き込みます。
+
+各行は Corporation.MarshalJSON の形式の JSON です。
+書き込んだデータは内部でバッファされるため, 最後に Flush を呼び出してください。
+*/
+type NDJSONWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewNDJSONWriter は NDJSONWriter を生成します。
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	return &NDJSONWriter{buf: buf, enc: enc}
+}
+
+// Write は法人情報を 1 行書き込みます。
+func (w *NDJSONWriter) Write(c corp.Corporation) error {
+	return w.enc.Encode(c)
+}
+
+// WriteResponse はレスポンスに含まれる法人情報を順に書き込みます。
+func (w *NDJSONWriter) WriteResponse(res corp.Response) error {
+	for _, c := range res.Corporations {
+		if err := w.Write(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush はバッファに残っているデータを書き込みます。
+func (w *NDJSONWriter) Flush() error {
+	return w.buf.Flush()
+}
+
+var (
+	_ Encoder = (*Writer)(nil)
+	_ Encoder = (*NDJSONWriter)(nil)
+)
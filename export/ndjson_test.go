@@ -0,0 +1,45 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+func TestNDJSONWriter(t *testing.T) {
+	res := testResponse(t)
+
+	var buf bytes.Buffer
+	w := NewNDJSONWriter(&buf)
+	if err := w.WriteResponse(res); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Error("data is written before Flush.")
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	var lines []corp.Corporation
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var c corp.Corporation
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			t.Fatalf("failed to parse line: %v", err)
+		}
+		lines = append(lines, c)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("line count is wrong. result:%d expected:2", len(lines))
+	}
+	for i, c := range lines {
+		if c.CorporateNumber != res.Corporations[i].CorporateNumber || c.Name != res.Corporations[i].Name {
+			t.Errorf("line %d is wrong. result:%+v", i, c)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+var (
+	// ErrWriterClosed は Flush 後の XLSXWriter に書き込んだ場合のエラーです。
+	ErrWriterClosed = errors.New("xlsx writer is already closed")
+	// ErrInvalidSheetName は Excel で使用できないシート名を指定した場合のエラーです。
+	ErrInvalidSheetName = errors.New("invalid sheet name")
+)
+
+// シート名の最大の文字数
+const maxSheetNameLength = 31
+
+// XLSXOptions は XLSX の出力の設定です。
+type XLSXOptions struct {
+	// 出力する列, 見出しの言語などの設定
+	// BOM は無視されます
+	Options
+	// シート名
+	// 未指定の場合は "corporations"
+	// 31 文字以内で : \ / ? * [ ] を含まず, 先頭と末尾が ' でない名前を指定してください
+	SheetName string
+	// 見出し行でウィンドウ枠を固定しないか
+	NoFreeze bool
+	// 見出し行にオートフィルタを設定しないか
+	NoAutoFilter bool
+}
+
+// cellType は XLSX のセルの型です。
+type cellType int
+
+const (
+	cellText cellType = iota
+	cellNumber
+	cellDate
+	cellBool
+)
+
+// columnTypes は文字列以外で出力する列の型です。
+// 法人番号やコード値は先頭の 0 や桁数を保つため文字列で出力します。
+var columnTypes = map[Column]cellType{
+	ColumnSequenceNumber: cellNumber,
+	ColumnCorrect:        cellBool,
+	ColumnUpdateDate:     cellDate,
+	ColumnChangeDate:     cellDate,
+	ColumnCloseDate:      cellDate,
+	ColumnAssignmentDate: cellDate,
+	ColumnLatest:         cellBool,
+	ColumnHihyoji:        cellBool,
+}
+
+// styles.xml の cellXfs のインデックス
+const (
+	styleText   = 1
+	styleDate   = 2
+	styleHeader = 3
+)
+
+/*
+XLSXWriter は法人情報を Excel のブック(XLSX)で書き込みます。
+
+法人番号, 郵便番号, コード値は文字列のセルとして書き込むため,
+Excel で開いても先頭の 0 が欠けたり指数表記になったりしません。
+日付は日付の書式を設定した数値のセルとして書き込みます。
+
+行はシートに 1 件ずつ書き込まれます。
+ブックは Flush の呼び出しで完成するため, 最後に必ず Flush を呼び出してください。
+Flush 後に書き込んだ場合は ErrWriterClosed を返します。
+*/
+type XLSXWriter struct {
+	zip     *zip.Writer
+	sheet   *bufio.Writer
+	columns []Column
+	locale  corp.Locale
+	opts    XLSXOptions
+	rows    int
+	started bool
+	closed  bool
+}
+
+/*
+NewXLSXWriter は XLSXWriter を生成します。
+
+出力できない列が指定された場合はエラーを, Excel で使用できないシート名が指定された場合は
+ErrInvalidSheetName をラップしたエラーを返します。
+*/
+func NewXLSXWriter(w io.Writer, opts XLSXOptions) (*XLSXWriter, error) {
+	columns, err := opts.columns()
+	if err != nil {
+		return nil, err
+	}
+	if opts.SheetName == "" {
+		opts.SheetName = "corporations"
+	}
+	if err := validateSheetName(opts.SheetName); err != nil {
+		return nil, err
+	}
+
+	return &XLSXWriter{
+		zip:     zip.NewWriter(w),
+		columns: columns,
+		locale:  opts.locale(),
+		opts:    opts,
+	}, nil
+}
+
+// Write は法人情報を 1 行書き込みます。
+func (w *XLSXWriter) Write(c corp.Corporation) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	w.rows++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows)
+	for i, col := range w.columns {
+		if err := w.writeCell(cellRef(i, w.rows), columnTypes[col], col.Value(c, w.locale)); err != nil {
+			return err
+		}
+	}
+	_, err := w.sheet.WriteString("</row>")
+	return err
+}
+
+// WriteResponse はレスポンスに含まれる法人情報を順に書き込みます。
+func (w *XLSXWriter) WriteResponse(res corp.Response) error {
+	for _, c := range res.Corporations {
+		if err := w.Write(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush はシートを閉じてブックを完成させます。2 回目以降の呼び出しは何もしません。
+func (w *XLSXWriter) Flush() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.start(); err != nil {
+		return err
+	}
+	w.closed = true
+
+	w.sheet.WriteString("</sheetData>")
+	if !w.opts.NoAutoFilter && w.header() {
+		fmt.Fprintf(w.sheet, `<autoFilter ref="A1:%s"/>`, cellRef(len(w.columns)-1, w.rows))
+	}
+	w.sheet.WriteString("</worksheet>")
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	// オートフィルタの範囲は行数が確定してから定義名に設定する
+	if err := w.writePart("xl/workbook.xml", w.workbook()); err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// workbook は xl/workbook.xml の内容を返します。
+func (w *XLSXWriter) workbook() string {
+	var name strings.Builder
+	xml.EscapeText(&name, []byte(w.opts.SheetName))
+
+	var definedNames string
+	if !w.opts.NoAutoFilter && w.header() {
+		var ref strings.Builder
+		xml.EscapeText(&ref, []byte(fmt.Sprintf("'%s'!$A$1:$%s$%d",
+			strings.ReplaceAll(w.opts.SheetName, "'", "''"), columnName(len(w.columns)-1), w.rows)))
+		definedNames = `<definedNames><definedName name="_xlnm._FilterDatabase" localSheetId="0" hidden="1">` + ref.String() + `</definedName></definedNames>`
+	}
+	return fmt.Sprintf(workbookXML, name.String(), definedNames)
+}
+
+func (w *XLSXWriter) writePart(name, body string) error {
+	f, err := w.zip.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, xml.Header+body)
+	return err
+}
+
+func (w *XLSXWriter) header() bool {
+	return !w.opts.NoHeader
+}
+
+// start はブックの固定部分とシートの先頭, 見出し行を書き込みます。
+func (w *XLSXWriter) start() error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	parts := []struct {
+		name string
+		body string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, p := range parts {
+		if err := w.writePart(p.name, p.body); err != nil {
+			return err
+		}
+	}
+
+	f, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(f)
+	w.sheet.WriteString(xml.Header)
+	w.sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if !w.opts.NoFreeze && w.header() {
+		w.sheet.WriteString(`<sheetViews><sheetView workbookViewId="0">` +
+			`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>` +
+			`<selection pane="bottomLeft" activeCell="A2" sqref="A2"/>` +
+			`</sheetView></sheetViews>`)
+	}
+	w.sheet.WriteString("<sheetData>")
+
+	if !w.header() {
+		return nil
+	}
+	w.rows++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows)
+	for i, col := range w.columns {
+		w.writeInlineString(cellRef(i, w.rows), styleHeader, col.Header(w.locale))
+	}
+	_, err = w.sheet.WriteString("</row>")
+	return err
+}
+
+// writeCell はセルを書き込みます。空の値はセルを省略します。
+func (w *XLSXWriter) writeCell(ref string, typ cellType, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch typ {
+	case cellNumber:
+		_, err := fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, value)
+		return err
+	case cellBool:
+		_, err := fmt.Fprintf(w.sheet, `<c r="%s" t="b"><v>%s</v></c>`, ref, value)
+		return err
+	case cellDate:
+		d, err := time.Parse(corp.DATE_FORMAT, value)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w.sheet, `<c r="%s" s="%d"><v>%s</v></c>`, ref, styleDate, serialDate(d))
+		return err
+	default:
+		return w.writeInlineString(ref, styleText, value)
+	}
+}
+
+func (w *XLSXWriter) writeInlineString(ref string, style int, value string) error {
+	fmt.Fprintf(w.sheet, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">`, ref, style)
+	if err := xml.EscapeText(w.sheet, []byte(value)); err != nil {
+		return err
+	}
+	_, err := w.sheet.WriteString("</t></is></c>")
+	return err
+}
+
+// validateSheetName は Excel のシート名の制限を満たすか検証します。
+func validateSheetName(name string) error {
+	if n := utf8.RuneCountInString(name); n > maxSheetNameLength {
+		return fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidSheetName, name, maxSheetNameLength)
+	}
+	if i := strings.IndexAny(name, ":\\/?*[]"); i >= 0 {
+		return fmt.Errorf("%w: %q contains %q", ErrInvalidSheetName, name, name[i])
+	}
+	if strings.HasPrefix(name, "'") || strings.HasSuffix(name, "'") {
+		return fmt.Errorf("%w: %q starts or ends with an apostrophe", ErrInvalidSheetName, name)
+	}
+	return nil
+}
+
+// cellRef は 0 始まりの列番号と 1 始まりの行番号をセル参照(例: A1)に変換します。
+func cellRef(col, row int) string {
+	return columnName(col) + strconv.Itoa(row)
+}
+
+// columnName は 0 始まりの列番号を列名(例: A, AA)に変換します。
+func columnName(col int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name
+}
+
+// serialDate は日付を Excel のシリアル値(1899-12-30 からの日数)に変換します。
+func serialDate(d time.Time) string {
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	days := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC).Sub(epoch).Hours() / 24
+	return strconv.Itoa(int(days))
+}
+
+const contentTypesXML = `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const relsXML = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookXML = `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>%s` +
+	`</workbook>`
+
+const workbookRelsXML = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// cellXfs は 0:標準, 1:文字列, 2:日付(yyyy-mm-dd), 3:見出し(太字)
+const stylesXML = `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy\-mm\-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="49" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="49" fontId="1" fillId="0" borderId="0" xfId="0" applyNumberFormat="1" applyFont="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+var _ Encoder = (*XLSXWriter)(nil)
@@ -0,0 +1,151 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+func TestXLSXWriter(t *testing.T) {
+	res := testResponse(t)
+
+	var buf bytes.Buffer
+	w, err := NewXLSXWriter(&buf, XLSXOptions{
+		Options: Options{
+			Columns: []Column{ColumnCorporateNumber, ColumnName, ColumnPostCode, ColumnAssignmentDate, ColumnLatest, ColumnCloseDate},
+			Locale:  corp.LocaleEn,
+		},
+		SheetName: "R&D",
+	})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	if err := w.WriteResponse(res); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	files := testUnzip(t, buf.Bytes())
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("%s is not found.", name)
+		}
+	}
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	for _, expected := range []string{
+		`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>`,
+		`<c r="A1" s="3" t="inlineStr"><is><t xml:space="preserve">Corporate Number</t></is></c>`,
+		`<c r="A2" s="1" t="inlineStr"><is><t xml:space="preserve">5070001032626</t></is></c>`,
+		`<c r="C3" s="1" t="inlineStr"><is><t xml:space="preserve">3710026</t></is></c>`,
+		`<c r="D2" s="2"><v>42618</v></c>`,
+		`<c r="E2" t="b"><v>1</v></c>`,
+		`<autoFilter ref="A1:F3"/>`,
+	} {
+		if !strings.Contains(sheet, expected) {
+			t.Errorf("sheet does not contain %s.\n%s", expected, sheet)
+		}
+	}
+	if strings.Contains(sheet, `r="F2"`) {
+		t.Error("empty cell is written.")
+	}
+
+	workbook := files["xl/workbook.xml"]
+	for _, expected := range []string{
+		`<sheet name="R&amp;D" sheetId="1" r:id="rId1"/>`,
+		`hidden="1">&#39;R&amp;D&#39;!$A$1:$F$3</definedName>`,
+	} {
+		if !strings.Contains(workbook, expected) {
+			t.Errorf("workbook does not contain %s.\n%s", expected, workbook)
+		}
+	}
+
+	if err := w.Write(corp.Corporation{}); err != ErrWriterClosed {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
+func TestXLSXWriterWithoutHeader(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewXLSXWriter(&buf, XLSXOptions{Options: Options{Columns: []Column{ColumnName}, NoHeader: true}})
+	_ = w.Write(corp.Corporation{Name: "<株式会社>"})
+	if err := w.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	files := testUnzip(t, buf.Bytes())
+	sheet := files["xl/worksheets/sheet1.xml"]
+	if strings.Contains(sheet, "<pane") || strings.Contains(sheet, "<autoFilter") {
+		t.Errorf("sheet has frozen pane or auto filter.\n%s", sheet)
+	}
+	if !strings.Contains(sheet, `<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">&lt;株式会社&gt;</t></is></c>`) {
+		t.Errorf("sheet is wrong.\n%s", sheet)
+	}
+	if strings.Contains(files["xl/workbook.xml"], "definedName") {
+		t.Error("workbook has defined name.")
+	}
+}
+
+func TestXLSXWriterSheetName(t *testing.T) {
+	valid := []string{"", "法人一覧2024年4月", "R&D (Tokyo)", strings.Repeat("法", 31), "It's"}
+	for _, name := range valid {
+		if _, err := NewXLSXWriter(io.Discard, XLSXOptions{SheetName: name}); err != nil {
+			t.Errorf("%q: error! %v", name, err)
+		}
+	}
+
+	invalid := []string{strings.Repeat("a", 32), "2024/04", "a:b", "a\\b", "what?", "a*", "[list]", "'quoted'"}
+	for _, name := range invalid {
+		if _, err := NewXLSXWriter(io.Discard, XLSXOptions{SheetName: name}); !errors.Is(err, ErrInvalidSheetName) {
+			t.Errorf("%q: error is wrong. result:%v", name, err)
+		}
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	tests := map[string]struct {
+		col, row int
+		expected string
+	}{
+		"A1":   {0, 1, "A1"},
+		"Z10":  {25, 10, "Z10"},
+		"AA2":  {26, 2, "AA2"},
+		"AZ3":  {51, 3, "AZ3"},
+		"BA4":  {52, 4, "BA4"},
+		"AAA5": {702, 5, "AAA5"},
+	}
+
+	for name, tt := range tests {
+		if result := cellRef(tt.col, tt.row); result != tt.expected {
+			t.Errorf("%s: result:%s expected:%s", name, result, tt.expected)
+		}
+	}
+}
+
+func testUnzip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("failed to open zip: %v", err)
+	}
+
+	files := map[string]string{}
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", f.Name, err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	return files
+}
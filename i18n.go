@@ -0,0 +1,414 @@
+package corp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator"
+)
+
+// Locale は表示用テキストの言語です。
+type Locale string
+
+const (
+	// 日本語
+	LocaleJa Locale = "ja"
+	// 英語
+	LocaleEn Locale = "en"
+)
+
+/*
+Catalog はメッセージキーと表示用テキストの対応です。
+
+キーは次の形式です。
+
+・処理区分: process.<コード> (例: process.11)
+
+・法人種別: kind.<コード> (例: kind.301)
+
+・登記記録の閉鎖等の事由: closeCause.<コード> (例: closeCause.01)
+
+・フィールド名: field.<フィールド名> (例: field.Name)
+
+・未知のコード: unknown.process, unknown.kind, unknown.closeCause
+
+・Web-API のエラー: error.badRequest, error.forbidden, error.notFound, error.internal
+
+・バリデーションエラー: validation.<タグ> (例: validation.required), validation.default
+
+テキストには fmt の書式を含めることができます。
+*/
+type Catalog map[string]string
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[Locale]Catalog{}
+	// 既定の言語
+	locale = LocaleJa
+)
+
+func init() {
+	ja := Catalog{
+		"unknown.process":    "不明な処理区分(%s)",
+		"unknown.kind":       "不明な法人種別(%s)",
+		"unknown.closeCause": "不明な閉鎖等の事由(%s)",
+
+		"field.SequenceNumber":           "一連番号",
+		"field.CorporateNumber":          "法人番号",
+		"field.Process":                  "処理区分",
+		"field.ProcessLabel":             "処理区分(名称)",
+		"field.KindLabel":                "法人種別(名称)",
+		"field.CloseCauseLabel":          "登記記録の閉鎖等の事由(名称)",
+		"field.Correct":                  "訂正区分",
+		"field.UpdateDate":               "更新年月日",
+		"field.ChangeDate":               "変更年月日",
+		"field.Name":                     "商号又は名称",
+		"field.NameImageId":              "商号又は名称イメージID",
+		"field.AddressImageId":           "国内所在地イメージID",
+		"field.AddressOutsideImageId":    "国外所在地イメージID",
+		"field.ChangeCause":              "変更事由の詳細",
+		"field.AssignmentDate":           "法人番号指定年月日",
+		"field.Latest":                   "最新履歴",
+		"field.Hihyoji":                  "検索対象除外",
+		"field.Furigana":                 "フリガナ",
+		"field.Kind":                     "法人種別",
+		"field.PrefectureName":           "国内所在地(都道府県)",
+		"field.CityName":                 "国内所在地(市区町村)",
+		"field.StreetNumber":             "国内所在地(丁目番地等)",
+		"field.PrefectureCode":           "都道府県コード",
+		"field.CityCode":                 "市区町村コード",
+		"field.PostCode":                 "郵便番号",
+		"field.AddressOutside":           "国外所在地",
+		"field.CloseDate":                "登記記録の閉鎖等年月日",
+		"field.CloseCause":               "登記記録の閉鎖等の事由",
+		"field.SuccessorCorporateNumber": "承継先法人番号",
+		"field.EnName":                   "商号又は名称(英語表記)",
+		"field.EnPrefectureName":         "国内所在地(都道府県)(英語表記)",
+		"field.EnCityName":               "国内所在地(市区町村)(英語表記)",
+		"field.EnAddressOutside":         "国外所在地(英語表記)",
+		"field.ID":                       "アプリケーションID",
+		"field.Numbers":                  "法人番号",
+		"field.From":                     "開始日",
+		"field.To":                       "終了日",
+		"field.Address":                  "所在地",
+		"field.Divide":                   "分割番号",
+		"field.Mode":                     "検索方式",
+		"field.Target":                   "検索対象",
+		"field.ResponseType":             "応答形式",
+
+		"format.empty": "(なし)",
+		"format.arrow": "→",
+
+		"error.badRequest": "%s:%s",
+		"error.forbidden":  "同一アプリケーションIDで一定期間内に多数のアクセスが実行されたため制限されています。",
+		"error.notFound":   "アプリケーションIDが登録されていないまたは無効です。",
+		"error.internal":   "法人番号システム Web-API に問題が発生しています。",
+
+		"validation.required":    "%sは必須です。",
+		"validation.min":         "%sは%s以上で指定してください。",
+		"validation.max":         "%sは%s以下で指定してください。",
+		"validation.eq":          "%sは%sを指定してください。",
+		"validation.date":        "%sはYYYY-MM-DD形式で指定してください。",
+		"validation.gtedate":     "%sは%s以降の日付を指定してください。",
+		"validation.address":     "%sは都道府県コード(2桁)または都道府県コード+市区町村コード(5桁)で指定してください。",
+		"validation.kind":        "%sに不正な法人種別コードが含まれています。",
+		"validation.checkdigits": "%sにチェックデジットが一致しない法人番号が含まれています。",
+		"validation.default":     "%sの値が不正です。",
+	}
+	for p, text := range processes {
+		ja["process."+string(p)] = text
+	}
+	for k, text := range kinds {
+		ja[fmt.Sprintf("kind.%d", k)] = text
+	}
+	for c, text := range closeCauses {
+		ja["closeCause."+string(c)] = text
+	}
+
+	en := Catalog{
+		"process.01": "New",
+		"process.11": "Change of trade name or name",
+		"process.12": "Change of domestic address",
+		"process.13": "Change of address outside Japan",
+		"process.21": "Closure of registration record, etc.",
+		"process.22": "Restoration of registration record, etc.",
+		"process.71": "Absorption-type merger",
+		"process.72": "Invalidation of absorption-type merger",
+		"process.81": "Erasure of trade name registration",
+		"process.99": "Deletion",
+
+		"kind.101": "National government organization",
+		"kind.201": "Local public entity",
+		"kind.301": "Stock company",
+		"kind.302": "Limited company",
+		"kind.303": "General partnership company",
+		"kind.304": "Limited partnership company",
+		"kind.305": "Limited liability company",
+		"kind.399": "Other incorporated registered entity",
+		"kind.401": "Foreign company, etc.",
+		"kind.499": "Other",
+
+		"closeCause.01": "Completion of liquidation, etc.",
+		"closeCause.11": "Dissolution by merger, etc.",
+		"closeCause.21": "Closure by registrar",
+		"closeCause.31": "Completion of other liquidation, etc.",
+
+		"unknown.process":    "Unknown process (%s)",
+		"unknown.kind":       "Unknown kind (%s)",
+		"unknown.closeCause": "Unknown close cause (%s)",
+
+		"field.SequenceNumber":           "Sequence Number",
+		"field.CorporateNumber":          "Corporate Number",
+		"field.Process":                  "Process",
+		"field.ProcessLabel":             "Process Name",
+		"field.KindLabel":                "Kind Name",
+		"field.CloseCauseLabel":          "Close Cause Name",
+		"field.Correct":                  "Correct",
+		"field.UpdateDate":               "Update Date",
+		"field.ChangeDate":               "Change Date",
+		"field.Name":                     "Name",
+		"field.NameImageId":              "Name Image ID",
+		"field.AddressImageId":           "Address Image ID",
+		"field.AddressOutsideImageId":    "Address Outside Japan Image ID",
+		"field.ChangeCause":              "Change Cause",
+		"field.AssignmentDate":           "Assignment Date",
+		"field.Latest":                   "Latest",
+		"field.Hihyoji":                  "Excluded From Search",
+		"field.Furigana":                 "Furigana",
+		"field.Kind":                     "Kind",
+		"field.PrefectureName":           "Prefecture",
+		"field.CityName":                 "City",
+		"field.StreetNumber":             "Street Number",
+		"field.PrefectureCode":           "Prefecture Code",
+		"field.CityCode":                 "City Code",
+		"field.PostCode":                 "Post Code",
+		"field.AddressOutside":           "Address Outside Japan",
+		"field.CloseDate":                "Close Date",
+		"field.CloseCause":               "Close Cause",
+		"field.SuccessorCorporateNumber": "Successor Corporate Number",
+		"field.EnName":                   "English Name",
+		"field.EnPrefectureName":         "English Prefecture",
+		"field.EnCityName":               "English City",
+		"field.EnAddressOutside":         "English Address Outside Japan",
+		"field.ID":                       "Application ID",
+		"field.Numbers":                  "Corporate Numbers",
+		"field.From":                     "From",
+		"field.To":                       "To",
+		"field.Address":                  "Address",
+		"field.Divide":                   "Divide Number",
+		"field.Mode":                     "Search Mode",
+		"field.Target":                   "Search Target",
+		"field.ResponseType":             "Response Type",
+
+		"format.empty": "(none)",
+		"format.arrow": "->",
+
+		"error.badRequest": "bad request (%s): %s",
+		"error.forbidden":  "access is restricted because too many requests were made with the same application ID in a certain period.",
+		"error.notFound":   "the application ID is not registered or is invalid.",
+		"error.internal":   "a problem occurred in the Corporate Number System Web-API.",
+
+		"validation.required":    "%s is required.",
+		"validation.min":         "%s must be at least %s.",
+		"validation.max":         "%s must be at most %s.",
+		"validation.eq":          "%s must be %s.",
+		"validation.date":        "%s must be in YYYY-MM-DD format.",
+		"validation.gtedate":     "%s must be on or after %s.",
+		"validation.address":     "%s must be a prefecture code (2 digits) or a prefecture and city code (5 digits).",
+		"validation.kind":        "%s contains an invalid kind code.",
+		"validation.checkdigits": "%s contains a corporate number with a mismatched check digit.",
+		"validation.default":     "%s is invalid.",
+	}
+
+	RegisterCatalog(LocaleJa, ja)
+	RegisterCatalog(LocaleEn, en)
+}
+
+/*
+RegisterCatalog は言語のカタログを登録します。
+
+登録済みの言語の場合は既存のカタログに追加し, 同じキーは上書きします。
+カタログにないキーは日本語のカタログで補完されます。
+*/
+func RegisterCatalog(l Locale, c Catalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+
+	if catalogs[l] == nil {
+		catalogs[l] = Catalog{}
+	}
+	for key, text := range c {
+		catalogs[l][key] = text
+	}
+}
+
+// SetLocale は表示用テキストやエラーメッセージの既定の言語を設定します。初期値は LocaleJa です。
+func SetLocale(l Locale) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	locale = l
+}
+
+// CurrentLocale は既定の言語を返します。
+func CurrentLocale() Locale {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+	return locale
+}
+
+/*
+Translate はカタログからキーに対応するテキストを返します。
+
+指定した言語にキーがない場合は日本語のテキストを, 日本語にもない場合はキーを返します。
+args を指定した場合はテキストを書式として扱います。
+*/
+func Translate(l Locale, key string, args ...interface{}) string {
+	text, ok := lookup(l, key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// FieldLabel はフィールド名の表示用テキストを返します。
+func FieldLabel(l Locale, field string) string {
+	if text, ok := lookup(l, "field."+field); ok {
+		return text
+	}
+	return field
+}
+
+// Label は指定した言語の表示用テキストを返します。未知の処理区分の場合はコードを含むテキストを返します。
+func (p Process) Label(l Locale) string {
+	if p == "" {
+		return ""
+	}
+	if text, ok := lookup(l, "process."+string(p)); ok {
+		return text
+	}
+	return Translate(l, "unknown.process", string(p))
+}
+
+// Label は指定した言語の表示用テキストを返します。未知の法人種別の場合はコードを含むテキストを返します。
+func (k Kind) Label(l Locale) string {
+	if k == 0 {
+		return ""
+	}
+	if text, ok := lookup(l, fmt.Sprintf("kind.%d", k)); ok {
+		return text
+	}
+	return Translate(l, "unknown.kind", fmt.Sprintf("%d", uint16(k)))
+}
+
+// Label は指定した言語の表示用テキストを返します。未知の事由の場合はコードを含むテキストを返します。
+func (c CloseCause) Label(l Locale) string {
+	if c == "" {
+		return ""
+	}
+	if text, ok := lookup(l, "closeCause."+string(c)); ok {
+		return text
+	}
+	return Translate(l, "unknown.closeCause", string(c))
+}
+
+/*
+APIError は法人番号システム Web-API がエラーを返した場合のエラーです。
+
+Error は既定の言語のメッセージを返します。
+詳細については Web-API 仕様書「HTTPステータスコード、エラーコード及びエラーメッセージ一覧」を参照してください。
+*/
+type APIError struct {
+	// HTTP ステータスコード
+	StatusCode int
+	// エラーコード
+	// HTTP ステータスコードが 400 の場合のみ設定
+	Code string
+	// Web-API から返されたエラーメッセージ
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return e.Localize(CurrentLocale())
+}
+
+/*
+Localize は指定した言語のエラーメッセージを返します。
+
+HTTP ステータスコードが 400 の場合, Web-API から返されたエラーメッセージは翻訳されません。
+*/
+func (e *APIError) Localize(l Locale) string {
+	switch e.StatusCode {
+	case 400:
+		if e.Code == "" {
+			return e.Message
+		}
+		return Translate(l, "error.badRequest", e.Code, e.Message)
+	case 403:
+		return Translate(l, "error.forbidden")
+	case 404:
+		return Translate(l, "error.notFound")
+	default:
+		return Translate(l, "error.internal")
+	}
+}
+
+/*
+LocalizeError はエラーを指定した言語のメッセージに変換します。
+
+APIError とリクエストのバリデーションエラーに対応し, それ以外のエラーは Error の結果をそのまま返します。
+バリデーションエラーが複数ある場合は改行で区切ります。
+*/
+func LocalizeError(err error, l Locale) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Localize(l)
+	}
+
+	var valErrs validator.ValidationErrors
+	if errors.As(err, &valErrs) {
+		msgs := make([]string, 0, len(valErrs))
+		for _, fe := range valErrs {
+			msgs = append(msgs, validationMessage(fe, l))
+		}
+		return strings.Join(msgs, "\n")
+	}
+
+	return err.Error()
+}
+
+func validationMessage(fe validator.FieldError, l Locale) string {
+	field := FieldLabel(l, fe.Field())
+	key := "validation." + fe.Tag()
+	if _, ok := lookup(l, key); !ok {
+		return Translate(l, "validation.default", field)
+	}
+
+	switch fe.Tag() {
+	case "min", "max", "eq":
+		return Translate(l, key, field, fe.Param())
+	case "gtedate":
+		return Translate(l, key, field, FieldLabel(l, fe.Param()))
+	default:
+		return Translate(l, key, field)
+	}
+}
+
+func lookup(l Locale, key string) (string, bool) {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	if text, ok := catalogs[l][key]; ok {
+		return text, true
+	}
+	text, ok := catalogs[LocaleJa][key]
+	return text, ok
+}
@@ -0,0 +1,146 @@
+package corp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/fillin-inc/go-corp/request"
+)
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		locale   Locale
+		label    string
+		expected string
+	}{
+		{LocaleJa, ProcessNameChanged.Label(LocaleJa), "商号又は名称の変更"},
+		{LocaleEn, ProcessNameChanged.Label(LocaleEn), "Change of trade name or name"},
+		{LocaleJa, KindLLC.Label(LocaleJa), "合同会社"},
+		{LocaleEn, KindLLC.Label(LocaleEn), "Limited liability company"},
+		{LocaleEn, Kind(402).Label(LocaleEn), "Unknown kind (402)"},
+		{LocaleJa, CloseCauseMerger.Label(LocaleJa), "合併による解散等"},
+		{LocaleEn, CloseCauseMerger.Label(LocaleEn), "Dissolution by merger, etc."},
+		{LocaleEn, Process("").Label(LocaleEn), ""},
+	}
+
+	for i, test := range tests {
+		if test.label != test.expected {
+			t.Errorf("%d: Label return wrong value result:%s expected:%s", i, test.label, test.expected)
+		}
+	}
+
+	if FieldLabel(LocaleEn, "PostCode") != "Post Code" || FieldLabel(LocaleJa, "PostCode") != "郵便番号" {
+		t.Errorf("FieldLabel return wrong value result:%s", FieldLabel(LocaleEn, "PostCode"))
+	}
+
+	if FieldLabel(LocaleJa, "Unknown") != "Unknown" {
+		t.Errorf("FieldLabel return wrong value result:%s", FieldLabel(LocaleJa, "Unknown"))
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer SetLocale(LocaleJa)
+	SetLocale(LocaleEn)
+
+	c := Corporation{Process: ProcessAbsorbed, Kind: KindStockCompany, CloseCause: CloseCauseMerger}
+	if c.ProcessText() != "Absorption-type merger" || c.KindText() != "Stock company" || c.CloseCauseText() != "Dissolution by merger, etc." {
+		t.Errorf("texts are not localized. result:%s, %s, %s", c.ProcessText(), c.KindText(), c.CloseCauseText())
+	}
+
+	err := &APIError{StatusCode: http.StatusNotFound}
+	if err.Error() != "the application ID is not registered or is invalid." {
+		t.Errorf("Error return wrong value result:%s", err.Error())
+	}
+}
+
+func TestRegisterCatalog(t *testing.T) {
+	zh := Locale("zh")
+	RegisterCatalog(zh, Catalog{"kind.301": "股份公司"})
+
+	if KindStockCompany.Label(zh) != "股份公司" {
+		t.Errorf("Label return wrong value result:%s", KindStockCompany.Label(zh))
+	}
+
+	// カタログにないキーは日本語で補完される
+	if KindLLC.Label(zh) != "合同会社" {
+		t.Errorf("Label return wrong value result:%s", KindLLC.Label(zh))
+	}
+
+	// 既存のカタログへの追加
+	RegisterCatalog(LocaleEn, Catalog{"kind.301": "Kabushiki Kaisha"})
+	defer RegisterCatalog(LocaleEn, Catalog{"kind.301": "Stock company"})
+	if KindStockCompany.Label(LocaleEn) != "Kabushiki Kaisha" || KindLLC.Label(LocaleEn) != "Limited liability company" {
+		t.Errorf("Label return wrong value result:%s", KindStockCompany.Label(LocaleEn))
+	}
+}
+
+func TestLocalizeError(t *testing.T) {
+	t.Run("APIError", func(t *testing.T) {
+		tests := []struct {
+			err *APIError
+			ja  string
+			en  string
+		}{
+			{
+				&APIError{StatusCode: http.StatusBadRequest, Code: "042", Message: "法人番号は10件以内で指定してください。"},
+				"042:法人番号は10件以内で指定してください。",
+				"bad request (042): 法人番号は10件以内で指定してください。",
+			},
+			{
+				&APIError{StatusCode: http.StatusForbidden},
+				"同一アプリケーションIDで一定期間内に多数のアクセスが実行されたため制限されています。",
+				"access is restricted because too many requests were made with the same application ID in a certain period.",
+			},
+			{
+				&APIError{StatusCode: http.StatusInternalServerError},
+				"法人番号システム Web-API に問題が発生しています。",
+				"a problem occurred in the Corporate Number System Web-API.",
+			},
+		}
+
+		for i, test := range tests {
+			wrapped := fmt.Errorf("wrapped: %w", test.err)
+			if LocalizeError(wrapped, LocaleJa) != test.ja {
+				t.Errorf("%d: ja message is wrong. result:%s", i, LocalizeError(wrapped, LocaleJa))
+			}
+
+			if LocalizeError(wrapped, LocaleEn) != test.en {
+				t.Errorf("%d: en message is wrong. result:%s", i, LocalizeError(wrapped, LocaleEn))
+			}
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		err := request.NewDiff("", "2021-07-20", "2021-07-19", "", []string{"05"}, 0).Validate()
+
+		expected := "アプリケーションIDは必須です。\n終了日は開始日以降の日付を指定してください。\n法人種別に不正な法人種別コードが含まれています。\n分割番号は1以上で指定してください。"
+		if LocalizeError(err, LocaleJa) != expected {
+			t.Errorf("ja message is wrong.\nresult:\n%s\nexpected:\n%s", LocalizeError(err, LocaleJa), expected)
+		}
+
+		expected = "Application ID is required.\nTo must be on or after From.\nKind contains an invalid kind code.\nDivide Number must be at least 1."
+		if LocalizeError(err, LocaleEn) != expected {
+			t.Errorf("en message is wrong.\nresult:\n%s\nexpected:\n%s", LocalizeError(err, LocaleEn), expected)
+		}
+	})
+
+	t.Run("Other", func(t *testing.T) {
+		if LocalizeError(errors.New("other"), LocaleEn) != "other" {
+			t.Error("other error is modified.")
+		}
+
+		if LocalizeError(nil, LocaleEn) != "" {
+			t.Error("nil error is not empty.")
+		}
+	})
+}
+
+func TestFieldChangesFormat(t *testing.T) {
+	changes := Compare(Corporation{PostCode: "3700813"}, Corporation{PostCode: "3700069"})
+
+	if changes.Format(LocaleEn) != "Post Code: 3700813 -> 3700069\n" {
+		t.Errorf("Format return wrong value result:%s", changes.Format(LocaleEn))
+	}
+}
@@ -0,0 +1,399 @@
+/*
+法人名の入力補完などに使用するメモリ上の検索索引のパッケージです。
+
+法人名を入力するたびに NameSearch を呼び出さずに済むよう, 法人情報から n-gram の索引を作成し,
+Web-API の法人名検索と同じ検索方式(前方一致・部分一致)と検索対象(あいまい・完全一致・英語表記)で検索します。
+
+	idx := index.New(res.Corporations...)
+	results := idx.Search(index.Query{Text: "ふぃるいん", Mode: index.ModePrefix, Target: index.TargetFuzzy, Limit: 10})
+
+索引は Save で改行区切りの JSON(NDJSON)として保存し, Load で読み込めます。
+export.NDJSONWriter で書き出したファイルも Load で読み込めます。
+*/
+package index
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	corp "github.com/fillin-inc/go-corp"
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// Mode は検索方式です。値は Web-API の mode と同じです。
+type Mode uint8
+
+const (
+	// 前方一致
+	ModePrefix Mode = 1
+	// 部分一致
+	ModePartial Mode = 2
+)
+
+// Target は検索対象です。値は Web-API の target と同じです。
+type Target uint8
+
+const (
+	/*
+		あいまい検索
+
+		商号又は名称, 法人格を除いた名称, フリガナを normalize.NameProfile, normalize.FuriganaProfile で正規化して検索します。
+		全角・半角, ひらがな・カタカナ, 異体字, 空白の違いを区別しません。
+	*/
+	TargetFuzzy Target = 1
+	// 完全一致検索(商号又は名称を正規化せずに検索します)
+	TargetExact Target = 2
+	// 英語表記(normalize.EnNameProfile で正規化して検索します)
+	TargetEnglish Target = 3
+)
+
+var targets = []Target{TargetFuzzy, TargetExact, TargetEnglish}
+
+// MatchType は検索語と名称の一致の種類です。値が小さいほど上位に並べます。
+type MatchType uint8
+
+const (
+	// 名称全体が一致
+	MatchExact MatchType = iota
+	// 名称の先頭が一致
+	MatchPrefix
+	// 名称の途中が一致
+	MatchPartial
+)
+
+// Query は検索条件です。
+type Query struct {
+	// 検索語
+	Text string
+	// 検索方式(省略時は部分一致)
+	Mode Mode
+	// 検索対象(省略時はあいまい検索)
+	Target Target
+	// 所在地
+	// 空文字, 都道府県コード(2桁)または都道府県コード+市区町村コード(5桁)
+	Address string
+	// 法人種別(省略時はすべて)
+	Kinds []corp.Kind
+	// 登記記録の閉鎖等がある法人(corp.Corporation.Closed)を含める
+	IncludeClosed bool
+	// 件数の上限(0 以下の場合はすべて)
+	Limit int
+}
+
+// Result は検索結果の 1 件です。
+type Result struct {
+	Corporation corp.Corporation
+	// 一致の種類
+	Match MatchType
+	// 一致した名称(検索対象に応じて正規化した値)
+	Key string
+}
+
+/*
+Index は法人名の検索索引です。
+
+同じ法人番号の法人情報は後から追加したもので置き換えます。
+複数の goroutine から同時に利用できます。
+*/
+type Index struct {
+	mu    sync.RWMutex
+	docs  []document
+	ids   map[uint64]uint32
+	grams map[Target]map[string][]uint32
+}
+
+type document struct {
+	corp corp.Corporation
+	keys map[Target][]string
+}
+
+// New は法人情報を指定して Index を生成します。
+func New(corps ...corp.Corporation) *Index {
+	idx := &Index{ids: map[uint64]uint32{}, grams: map[Target]map[string][]uint32{}}
+	for _, t := range targets {
+		idx.grams[t] = map[string][]uint32{}
+	}
+	idx.Add(corps...)
+	return idx
+}
+
+/*
+Load は Save で保存した索引を読み込みます。
+
+1 行に 1 件, Corporation.MarshalJSON の形式の JSON が記載されたデータから索引を作成します。
+*/
+func Load(r io.Reader) (*Index, error) {
+	idx := New()
+	if err := idx.AddFrom(r); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// AddFrom は 1 行に 1 件, Corporation.MarshalJSON の形式の JSON が記載されたデータを順に読み込み, 索引に追加します。
+func (idx *Index) AddFrom(r io.Reader) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	n := 0
+	for {
+		n++
+		var c corp.Corporation
+		err := dec.Decode(&c)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("record %d: %w", n, err)
+		}
+		idx.Add(c)
+	}
+}
+
+// Save は Load で読み込める形式で法人情報を追加した順に書き出します。
+func (idx *Index) Save(w io.Writer) error {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	for id, d := range idx.docs {
+		if !idx.live(uint32(id)) {
+			continue
+		}
+		if err := enc.Encode(d.corp); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Add は法人情報を索引に追加します。
+func (idx *Index) Add(corps ...corp.Corporation) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, c := range corps {
+		id := uint32(len(idx.docs))
+		d := document{corp: c, keys: keys(c)}
+		idx.docs = append(idx.docs, d)
+		idx.ids[c.CorporateNumber] = id
+		for t, ks := range d.keys {
+			for _, k := range ks {
+				for _, g := range indexGrams(k) {
+					postings := idx.grams[t][g]
+					if n := len(postings); n == 0 || postings[n-1] != id {
+						idx.grams[t][g] = append(postings, id)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Len は索引に含まれる法人の件数を返します。
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.ids)
+}
+
+/*
+Search は検索条件に一致する法人情報を返します。
+
+結果は一致の種類(名称全体, 先頭, 途中の順), 一致した名称と検索語の長さの差,
+閉鎖されていない法人, 法人番号の順に並べます。
+*/
+func (idx *Index) Search(q Query) []Result {
+	if q.Mode == 0 {
+		q.Mode = ModePartial
+	}
+	if q.Target == 0 {
+		q.Target = TargetFuzzy
+	}
+	text := normalizeKey(q.Target, q.Text)
+	if text == "" {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var results []Result
+	for _, id := range idx.candidates(q.Target, text) {
+		d := idx.docs[id]
+		if !idx.live(id) || !filter(q, d.corp) {
+			continue
+		}
+		if m, key, ok := match(q.Mode, text, d.keys[q.Target]); ok {
+			results = append(results, Result{Corporation: d.corp, Match: m, Key: key})
+		}
+	}
+
+	textLen := len([]rune(text))
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Match != b.Match {
+			return a.Match < b.Match
+		}
+		if da, db := len([]rune(a.Key))-textLen, len([]rune(b.Key))-textLen; da != db {
+			return da < db
+		}
+		if ca, cb := a.Corporation.Closed(), b.Corporation.Closed(); ca != cb {
+			return cb
+		}
+		return a.Corporation.CorporateNumber < b.Corporation.CorporateNumber
+	})
+
+	if q.Limit > 0 && len(results) > q.Limit {
+		results = results[:q.Limit]
+	}
+	return results
+}
+
+// live は id の法人情報が同じ法人番号の後の法人情報で置き換えられていないか判定します。
+func (idx *Index) live(id uint32) bool {
+	return idx.ids[idx.docs[id].corp.CorporateNumber] == id
+}
+
+// candidates は検索語のすべての n-gram を含む法人情報の id を返します。
+func (idx *Index) candidates(t Target, text string) []uint32 {
+	var result []uint32
+	for i, g := range grams(text) {
+		postings := idx.grams[t][g]
+		if i == 0 {
+			result = postings
+		} else {
+			result = intersect(result, postings)
+		}
+		if len(result) == 0 {
+			return nil
+		}
+	}
+	return result
+}
+
+// match は名称のうち検索語と最もよく一致するものを返します。一致の種類が同じ場合は短い名称を優先します。
+func match(mode Mode, text string, keys []string) (MatchType, string, bool) {
+	best, key, found := MatchPartial, "", false
+	for _, k := range keys {
+		var m MatchType
+		switch {
+		case k == text:
+			m = MatchExact
+		case strings.HasPrefix(k, text):
+			m = MatchPrefix
+		case mode == ModePartial && strings.Contains(k, text):
+			m = MatchPartial
+		default:
+			continue
+		}
+		if !found || m < best || (m == best && len(k) < len(key)) {
+			best, key, found = m, k, true
+		}
+	}
+	return best, key, found
+}
+
+func filter(q Query, c corp.Corporation) bool {
+	if c.Closed() && !q.IncludeClosed {
+		return false
+	}
+	if q.Address != "" && !strings.HasPrefix(fmt.Sprintf("%02d%03d", c.PrefectureCode, c.CityCode), q.Address) {
+		return false
+	}
+	if len(q.Kinds) == 0 {
+		return true
+	}
+	for _, k := range q.Kinds {
+		if k == c.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+// keys は検索対象ごとの法人情報の名称を返します。
+func keys(c corp.Corporation) map[Target][]string {
+	m := map[Target][]string{}
+	add := func(t Target, s string) {
+		if s == "" {
+			return
+		}
+		for _, k := range m[t] {
+			if k == s {
+				return
+			}
+		}
+		m[t] = append(m[t], s)
+	}
+
+	add(TargetFuzzy, normalizeKey(TargetFuzzy, c.Name))
+	add(TargetFuzzy, normalizeKey(TargetFuzzy, c.ParsedName().Core))
+	add(TargetFuzzy, normalize.Furigana(c.Furigana))
+	add(TargetExact, normalizeKey(TargetExact, c.Name))
+	add(TargetEnglish, normalizeKey(TargetEnglish, c.EnName))
+	return m
+}
+
+func normalizeKey(t Target, s string) string {
+	switch t {
+	case TargetFuzzy:
+		return normalize.Name(s)
+	case TargetEnglish:
+		return normalize.EnName(s)
+	}
+	return strings.TrimSpace(s)
+}
+
+// grams は検索語の 2 文字ずつの n-gram を返します。1 文字の場合はその文字を返します。
+func grams(s string) []string {
+	rs := []rune(s)
+	if len(rs) < 2 {
+		if len(rs) == 0 {
+			return nil
+		}
+		return []string{s}
+	}
+	gs := make([]string, 0, len(rs)-1)
+	for i := 0; i+1 < len(rs); i++ {
+		gs = append(gs, string(rs[i:i+2]))
+	}
+	return gs
+}
+
+// indexGrams は索引に登録する 1 文字と 2 文字の n-gram を返します。
+func indexGrams(s string) []string {
+	rs := []rune(s)
+	gs := make([]string, 0, len(rs)*2)
+	for i := range rs {
+		gs = append(gs, string(rs[i]))
+		if i+1 < len(rs) {
+			gs = append(gs, string(rs[i:i+2]))
+		}
+	}
+	return gs
+}
+
+// intersect は昇順の 2 つの id の共通部分を返します。
+func intersect(a, b []uint32) []uint32 {
+	var result []uint32
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
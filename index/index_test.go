@@ -0,0 +1,158 @@
+package index
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+func testCorporations() []corp.Corporation {
+	closeDate := corp.Date(time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC))
+	return []corp.Corporation{
+		{CorporateNumber: 5070001032626, Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: corp.KindStockCompany, PrefectureCode: 10, CityCode: 202, EnName: "Fillin Inc.", Latest: true},
+		{CorporateNumber: 1010001000006, Name: "フィルイン合同会社", Furigana: "フィルイン", Kind: corp.KindLLC, PrefectureCode: 13, CityCode: 101, Latest: true},
+		{CorporateNumber: 2070001000003, Name: "株式会社フィルインテック", Furigana: "フィルインテック", Kind: corp.KindStockCompany, PrefectureCode: 10, CityCode: 201, Latest: true},
+		{CorporateNumber: 3070001000001, Name: "株式会社ザ・フィルイン", Furigana: "ザフィルイン", Kind: corp.KindStockCompany, PrefectureCode: 10, CityCode: 202, CloseDate: &closeDate, Latest: true},
+		{CorporateNumber: 7000020100005, Name: "群馬県", Furigana: "グンマケン", Kind: corp.KindLocalGovernment, PrefectureCode: 10, EnName: "Gunma Prefectural Government", Latest: true},
+		{CorporateNumber: 4070001000000, Name: "株式会社髙橋商店", Furigana: "タカハシショウテン", Kind: corp.KindStockCompany, PrefectureCode: 10, CityCode: 202, Latest: true},
+	}
+}
+
+func numbers(results []Result) []uint64 {
+	var ns []uint64
+	for _, r := range results {
+		ns = append(ns, r.Corporation.CorporateNumber)
+	}
+	return ns
+}
+
+func equalNumbers(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSearch(t *testing.T) {
+	idx := New(testCorporations()...)
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected []uint64
+	}{
+		{"partial", Query{Text: "フィルイン"}, []uint64{1010001000006, 5070001032626, 2070001000003}},
+		{"prefix", Query{Text: "ふぃるいんて", Mode: ModePrefix}, []uint64{2070001000003}},
+		{"prefix excludes middle", Query{Text: "インテック", Mode: ModePrefix}, nil},
+		{"partial middle", Query{Text: "インテック"}, []uint64{2070001000003}},
+		{"furigana", Query{Text: "ｸﾞﾝﾏ", Mode: ModePrefix}, []uint64{7000020100005}},
+		{"single character", Query{Text: "群", Mode: ModePrefix}, []uint64{7000020100005}},
+		{"variant kanji", Query{Text: "高橋商店"}, []uint64{4070001000000}},
+		{"exact target", Query{Text: "高橋商店", Target: TargetExact}, nil},
+		{"exact target old kanji", Query{Text: "株式会社髙橋", Target: TargetExact, Mode: ModePrefix}, []uint64{4070001000000}},
+		{"english", Query{Text: "gunma", Target: TargetEnglish, Mode: ModePrefix}, []uint64{7000020100005}},
+		{"address prefecture", Query{Text: "フィルイン", Address: "10"}, []uint64{5070001032626, 2070001000003}},
+		{"address city", Query{Text: "フィルイン", Address: "10202"}, []uint64{5070001032626}},
+		{"kind", Query{Text: "フィルイン", Kinds: []corp.Kind{corp.KindLLC}}, []uint64{1010001000006}},
+		{"include closed", Query{Text: "フィルイン", Address: "10202", IncludeClosed: true}, []uint64{5070001032626, 3070001000001}},
+		{"limit", Query{Text: "フィルイン", Limit: 1}, []uint64{1010001000006}},
+		{"empty", Query{Text: " "}, nil},
+	}
+	for _, test := range tests {
+		if result := numbers(idx.Search(test.query)); !equalNumbers(result, test.expected) {
+			t.Errorf("%s: result:%v expected:%v", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestSearchDecodedCorporations(t *testing.T) {
+	// Web-API の応答では閉鎖されていない法人の閉鎖等年月日も空の要素(<closeDate />)になる
+	data, err := os.ReadFile("../testdata/response/name_search.xml")
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	var res corp.Response
+	if err := xml.Unmarshal(data, &res); err != nil {
+		t.Fatalf("error! %v", err)
+	}
+
+	idx := New(res.Corporations...)
+	if result := numbers(idx.Search(Query{Text: "フィルイン"})); !equalNumbers(result, []uint64{5070001032626}) {
+		t.Errorf("result:%v", result)
+	}
+}
+
+func TestSearchResult(t *testing.T) {
+	idx := New(testCorporations()...)
+	results := idx.Search(Query{Text: "フィルイン", Address: "10", IncludeClosed: true})
+	if len(results) != 3 {
+		t.Fatalf("length is wrong. result:%d", len(results))
+	}
+	expected := []Result{
+		{Match: MatchExact, Key: "フィルイン"},
+		{Match: MatchPrefix, Key: "フィルインテック"},
+		{Match: MatchPartial, Key: "ザフィルイン"},
+	}
+	for i, r := range results {
+		if r.Match != expected[i].Match || r.Key != expected[i].Key {
+			t.Errorf("result %d is wrong. result:%d %s expected:%d %s", i, r.Match, r.Key, expected[i].Match, expected[i].Key)
+		}
+	}
+}
+
+func TestAddReplaces(t *testing.T) {
+	idx := New(testCorporations()...)
+	idx.Add(corp.Corporation{CorporateNumber: 5070001032626, Name: "株式会社フィルインホールディングス", Latest: true})
+
+	if idx.Len() != 6 {
+		t.Errorf("length is wrong. result:%d", idx.Len())
+	}
+	if result := numbers(idx.Search(Query{Text: "フィルイン", Address: "10202"})); len(result) != 0 {
+		t.Errorf("replaced corporation is found. result:%v", result)
+	}
+	if result := numbers(idx.Search(Query{Text: "ホールディングス"})); !equalNumbers(result, []uint64{5070001032626}) {
+		t.Errorf("added corporation is not found. result:%v", result)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	idx := New(testCorporations()...)
+	idx.Add(corp.Corporation{CorporateNumber: 5070001032626, Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: corp.KindStockCompany, PrefectureCode: 10, CityCode: 202, Latest: true})
+
+	var buf bytes.Buffer
+	if err := idx.Save(&buf); err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 6 {
+		t.Errorf("line count is wrong. result:%d", lines)
+	}
+
+	loaded, err := Load(&buf)
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if loaded.Len() != idx.Len() {
+		t.Errorf("length is wrong. result:%d expected:%d", loaded.Len(), idx.Len())
+	}
+	query := Query{Text: "フィルイン", IncludeClosed: true}
+	if result, expected := numbers(loaded.Search(query)), numbers(idx.Search(query)); !equalNumbers(result, expected) {
+		t.Errorf("search result is wrong. result:%v expected:%v", result, expected)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	_, err := Load(strings.NewReader("{\"corporate_number\": \"5070001032626\"}\n{"))
+	if err == nil || !strings.HasPrefix(err.Error(), "record 2:") {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
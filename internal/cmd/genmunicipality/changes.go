@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fillin-inc/go-corp/address"
+)
+
+// 種類の名称と値の対応
+var changeTypes = map[string]address.ChangeType{
+	"市制施行": address.ChangeStatus,
+	"町制施行": address.ChangeStatus,
+}
+
+func init() {
+	for _, t := range []address.ChangeType{address.ChangeMerger, address.ChangeAbsorption, address.ChangeDesignation, address.ChangeStatus, address.ChangeWard} {
+		changeTypes[string(t)] = t
+		changeTypes[t.String()] = t
+	}
+}
+
+/*
+generateChanges は廃置分合等の一覧から address パッケージの団体コードの変更の一覧を生成します。
+
+総務省の廃置分合等の一覧を UTF-8 の CSV で保存し, 引数に指定します。
+CSV は 変更年月日, 種類, 廃止された団体コード, 廃止された市区町村名, 承継した団体コード, 承継した市区町村名 の列で構成され,
+1 行目は見出しとして読み飛ばします。
+
+・変更年月日は 2006-01-23 または 2006/1/23 の形式
+
+・種類は merger などの値または 新設合併, 編入合併 などの名称(市制施行, 町制施行 は status)
+
+・団体コードは 5 桁または検査数字を含む 6 桁
+
+生成済みの一覧も同じ形式のため, 入力に含めると既存の変更に追加できます。
+同じ変更は 1 行にまとめ, 変更年月日, 廃止された団体コード, 承継した団体コードの順に並べます。
+*/
+func generateChanges(out string, paths []string) error {
+	rows := map[string][]string{}
+	for _, path := range paths {
+		if err := readChanges(path, rows); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	list := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, row)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		for _, k := range []int{0, 2, 4} {
+			if list[i][k] != list[j][k] {
+				return list[i][k] < list[j][k]
+			}
+		}
+		return false
+	})
+	checkChanges(list)
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"date", "type", "code", "name", "successor_code", "successor_name"})
+	w.WriteAll(list)
+	return w.Error()
+}
+
+func readChanges(path string, rows map[string][]string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	// 見出し
+	if _, err := r.Read(); err != nil {
+		return err
+	}
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(row) < 6 {
+			continue
+		}
+
+		date, err := parseChangeDate(strings.TrimPrefix(row[0], "\ufeff"))
+		if err != nil {
+			return err
+		}
+		t, ok := changeTypes[strings.TrimSpace(row[1])]
+		if !ok {
+			return fmt.Errorf("unknown change type: %s", row[1])
+		}
+		code, err := fullLocalGovCode(row[2])
+		if err != nil {
+			return err
+		}
+		successor, err := fullLocalGovCode(row[4])
+		if err != nil {
+			return err
+		}
+
+		out := []string{date, string(t), code, address.Normalize(row[3]), successor, address.Normalize(row[5])}
+		rows[strings.Join(out, ",")] = out
+	}
+}
+
+func parseChangeDate(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "2006/1/2"} {
+		if d, err := time.Parse(layout, s); err == nil {
+			return d.Format("2006-01-02"), nil
+		}
+	}
+	return "", fmt.Errorf("invalid date: %s", s)
+}
+
+// fullLocalGovCode は 5 桁の団体コードに検査数字を付けて 6 桁にします。
+func fullLocalGovCode(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == 6 {
+		if !address.ValidLocalGovCode(code) {
+			return "", fmt.Errorf("invalid check digit: %s", code)
+		}
+		return code, nil
+	}
+	digit, err := address.CheckDigit(code)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%d", code, digit), nil
+}
+
+// checkChanges は廃止された団体コードが現在の一覧にないこと, 承継した団体コードが現在の一覧にあるか後に廃止されていることを確認します。
+func checkChanges(list [][]string) {
+	abolished := map[string]string{}
+	for _, row := range list {
+		if d, ok := abolished[row[2]]; !ok || row[0] > d {
+			abolished[row[2]] = row[0]
+		}
+	}
+	for _, row := range list {
+		if _, ok := address.MunicipalityByLocalGovCode(row[2]); ok {
+			log.Printf("abolished code exists: %s %s", row[2], row[3])
+		}
+		if _, ok := address.MunicipalityByLocalGovCode(row[4]); ok {
+			continue
+		}
+		if d, ok := abolished[row[4]]; !ok || d < row[0] {
+			log.Printf("unknown successor code: %s %s -> %s %s", row[2], row[3], row[4], row[5])
+		}
+	}
+}
@@ -0,0 +1,139 @@
+/*
+genmunicipality は総務省の全国地方公共団体コードの一覧から address パッケージの市区町村の一覧を生成します。
+
+一覧の Excel ファイルの各シート(政令指定都市の区を含む)を UTF-8 の CSV で保存し, 引数に指定します。
+CSV は 団体コード, 都道府県名(漢字), 市区町村名(漢字), 都道府県名(カナ), 市区町村名(カナ) の列で構成され,
+1 行目は見出しとして読み飛ばします。
+
+	go run ./internal/cmd/genmunicipality -o address/data/municipalities.csv 000925835.csv 000925836.csv
+
+-changes を指定した場合は廃置分合等の一覧から団体コードの変更の一覧を生成します(changes.go を参照)。
+
+	go run ./internal/cmd/genmunicipality -changes -o address/data/municipality_changes.csv address/data/municipality_changes.csv haichi.csv
+*/
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fillin-inc/go-corp/address"
+	"github.com/fillin-inc/go-corp/romaji"
+)
+
+// 市区町村名の接尾辞と読みに対応する英語表記の接尾辞
+var suffixes = []struct {
+	name, kana, en string
+}{
+	{"市", "シ", "-shi"},
+	{"区", "ク", "-ku"},
+	{"町", "マチ", "-machi"},
+	{"町", "チョウ", "-cho"},
+	{"村", "ムラ", "-mura"},
+	{"村", "ソン", "-son"},
+}
+
+func main() {
+	out := flag.String("o", "", "output file")
+	changes := flag.Bool("changes", false, "generate municipality changes")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("input csv is required")
+	}
+	if *changes {
+		if *out == "" {
+			*out = "address/data/municipality_changes.csv"
+		}
+		if err := generateChanges(*out, flag.Args()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *out == "" {
+		*out = "address/data/municipalities.csv"
+	}
+
+	records := map[string][]string{}
+	for _, path := range flag.Args() {
+		if err := read(path, records); err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+	}
+
+	codes := make([]string, 0, len(records))
+	for code := range records {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"code", "name", "kana", "en_name"})
+	for _, code := range codes {
+		w.Write(records[code])
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func read(path string, records map[string][]string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	// 見出し
+	if _, err := r.Read(); err != nil {
+		return err
+	}
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(row) < 5 {
+			continue
+		}
+
+		code := strings.TrimSpace(strings.TrimPrefix(row[0], "\ufeff"))
+		name := address.Normalize(row[2])
+		kana := address.Normalize(row[4])
+		// 都道府県の行
+		if name == "" {
+			continue
+		}
+		if len(code) != 6 || !address.ValidLocalGovCode(code) {
+			log.Printf("skip invalid code: %s %s", code, name)
+			continue
+		}
+		records[code] = []string{code, name, kana, enName(name, kana)}
+	}
+}
+
+// enName は読みから市区町村の英語表記(例: Takasaki-shi)を生成します。
+func enName(name, kana string) string {
+	for _, s := range suffixes {
+		if strings.HasSuffix(name, s.name) && strings.HasSuffix(kana, s.kana) {
+			return romaji.Capitalize(romaji.Convert(strings.TrimSuffix(kana, s.kana))) + s.en
+		}
+	}
+	return romaji.Capitalize(romaji.Convert(kana))
+}
@@ -0,0 +1,24 @@
+// genschema は Corporation と Response の JSON 表現の JSON Schema を生成します。
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/fillin-inc/go-corp"
+)
+
+func main() {
+	out := flag.String("o", "schema/corporation.schema.json", "output file")
+	flag.Parse()
+
+	b, err := corp.JSONSchema()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, b, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
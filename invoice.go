@@ -0,0 +1,210 @@
+package corp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fillin-inc/go-corp/checkdigit"
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// ErrRegistrationNumberPrefix は登録番号が T から始まらない場合のエラーです。
+var ErrRegistrationNumberPrefix = errors.New("registration number must start with T")
+
+/*
+RegistrationNumber は適格請求書発行事業者の登録番号(T + 13 桁)です。ゼロ値は登録番号がないことを表します。
+
+法人の登録番号は T と法人番号, 個人事業者などの登録番号は T と法人番号と同じ方式のチェックデジットを持つ 13 桁の番号です。
+登録番号だけでは法人か個人事業者かを判別できないため, 法人であることがわかっている場合は CorporateNumber で,
+個人事業者であることがわかっている場合は SoleProprietor で変換してください。
+
+テキスト, JSON, XML では String の形式(例: "T5070001032626")に変換します。
+CorporateNumber と同じく, JSON ではゼロ値を null とします。
+*/
+type RegistrationNumber uint64
+
+/*
+ParseRegistrationNumber は文字列を登録番号に変換します。
+
+全角の文字, 小文字の t と, 数字の間の空白・ハイフンに対応します。
+
+	ParseRegistrationNumber("T5070001032626")
+	ParseRegistrationNumber("Ｔ５０７０００１０３２６２６")
+	ParseRegistrationNumber("T5-0700-0103-2626")
+
+T から始まらない場合は ErrRegistrationNumberPrefix を返します。
+番号の部分のエラーは ParseCorporateNumber と同じです。
+*/
+func ParseRegistrationNumber(s string) (RegistrationNumber, error) {
+	n, err := parseRegistrationNumber(s)
+	return RegistrationNumber(n), err
+}
+
+func parseRegistrationNumber(s string) (uint64, error) {
+	str := strings.TrimSpace(normalize.Width(s))
+	if !strings.HasPrefix(str, "T") && !strings.HasPrefix(str, "t") {
+		return 0, fmt.Errorf("%w: %q", ErrRegistrationNumberPrefix, s)
+	}
+	return parseNumber(s, str[1:])
+}
+
+// Validate は登録番号の桁数とチェックデジットを検証します。エラーは checkdigit.Validate と同じです。
+func (n RegistrationNumber) Validate() error {
+	return checkdigit.Validate(uint64(n))
+}
+
+// String は T と 13 桁の番号(例: T5070001032626)を返します。ゼロ値の場合は空文字を返します。
+func (n RegistrationNumber) String() string {
+	return registrationString(uint64(n))
+}
+
+/*
+CorporateNumber は法人の登録番号を法人番号に変換します。
+
+個人事業者などの登録番号は法人番号ではないため, 法人の登録番号であることがわかっている場合のみ使用してください。
+*/
+func (n RegistrationNumber) CorporateNumber() CorporateNumber {
+	return CorporateNumber(n)
+}
+
+// SoleProprietor は個人事業者などの登録番号を SoleProprietorNumber に変換します。
+func (n RegistrationNumber) SoleProprietor() SoleProprietorNumber {
+	return SoleProprietorNumber(n)
+}
+
+// MarshalText は登録番号を String の形式に変換します。
+func (n RegistrationNumber) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText は ParseRegistrationNumber で登録番号を読み込みます。空文字はゼロ値とします。
+func (n *RegistrationNumber) UnmarshalText(b []byte) error {
+	v, err := unmarshalRegistrationNumber(b)
+	if err != nil {
+		return err
+	}
+	*n = RegistrationNumber(v)
+	return nil
+}
+
+// MarshalJSON は登録番号を String の形式の文字列に変換します。ゼロ値は null とします。
+func (n RegistrationNumber) MarshalJSON() ([]byte, error) {
+	return marshalRegistrationNumberJSON(uint64(n))
+}
+
+// UnmarshalJSON は String の形式の登録番号を読み込みます。null と空文字はゼロ値とします。
+func (n *RegistrationNumber) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalRegistrationNumberJSON(b)
+	if err != nil {
+		return err
+	}
+	*n = RegistrationNumber(v)
+	return nil
+}
+
+/*
+SoleProprietorNumber は法人以外(個人事業者, 人格のない社団等)の適格請求書発行事業者の登録番号です。
+
+法人番号ではないため CorporateNumber に変換するメソッドはありません。
+法人番号 Web-API の ByNumber などに指定しないでください。
+*/
+type SoleProprietorNumber uint64
+
+// ParseSoleProprietorNumber は文字列を個人事業者などの登録番号に変換します。形式とエラーは ParseRegistrationNumber と同じです。
+func ParseSoleProprietorNumber(s string) (SoleProprietorNumber, error) {
+	n, err := parseRegistrationNumber(s)
+	return SoleProprietorNumber(n), err
+}
+
+// Validate は登録番号の桁数とチェックデジットを検証します。エラーは checkdigit.Validate と同じです。
+func (n SoleProprietorNumber) Validate() error {
+	return checkdigit.Validate(uint64(n))
+}
+
+// String は T と 13 桁の番号(例: T5070001032626)を返します。ゼロ値の場合は空文字を返します。
+func (n SoleProprietorNumber) String() string {
+	return registrationString(uint64(n))
+}
+
+// RegistrationNumber は RegistrationNumber に変換します。
+func (n SoleProprietorNumber) RegistrationNumber() RegistrationNumber {
+	return RegistrationNumber(n)
+}
+
+// MarshalText は登録番号を String の形式に変換します。
+func (n SoleProprietorNumber) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText は ParseSoleProprietorNumber で登録番号を読み込みます。空文字はゼロ値とします。
+func (n *SoleProprietorNumber) UnmarshalText(b []byte) error {
+	v, err := unmarshalRegistrationNumber(b)
+	if err != nil {
+		return err
+	}
+	*n = SoleProprietorNumber(v)
+	return nil
+}
+
+// MarshalJSON は登録番号を String の形式の文字列に変換します。ゼロ値は null とします。
+func (n SoleProprietorNumber) MarshalJSON() ([]byte, error) {
+	return marshalRegistrationNumberJSON(uint64(n))
+}
+
+// UnmarshalJSON は String の形式の登録番号を読み込みます。null と空文字はゼロ値とします。
+func (n *SoleProprietorNumber) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalRegistrationNumberJSON(b)
+	if err != nil {
+		return err
+	}
+	*n = SoleProprietorNumber(v)
+	return nil
+}
+
+// RegistrationNumber は法人番号を適格請求書発行事業者の登録番号の形式に変換します。
+func (n CorporateNumber) RegistrationNumber() RegistrationNumber {
+	return RegistrationNumber(n)
+}
+
+/*
+RegistrationNumber は法人番号から適格請求書発行事業者の登録番号の形式の番号を返します。
+
+法人が適格請求書発行事業者として登録されているかは確認しません。
+*/
+func (c Corporation) RegistrationNumber() RegistrationNumber {
+	return RegistrationNumber(c.CorporateNumber)
+}
+
+func registrationString(n uint64) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("T%013d", n)
+}
+
+func unmarshalRegistrationNumber(b []byte) (uint64, error) {
+	if strings.TrimSpace(string(b)) == "" {
+		return 0, nil
+	}
+	return parseRegistrationNumber(string(b))
+}
+
+func marshalRegistrationNumberJSON(n uint64) ([]byte, error) {
+	if n == 0 {
+		return []byte("null"), nil
+	}
+	return json.Marshal(registrationString(n))
+}
+
+func unmarshalRegistrationNumberJSON(b []byte) (uint64, error) {
+	if string(b) == "null" {
+		return 0, nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, err
+	}
+	return unmarshalRegistrationNumber([]byte(s))
+}
@@ -0,0 +1,124 @@
+package corp
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/fillin-inc/go-corp/checkdigit"
+)
+
+func TestParseRegistrationNumber(t *testing.T) {
+	tests := []string{
+		"T5070001032626",
+		"t5070001032626",
+		"Ｔ５０７０００１０３２６２６",
+		"T5-0700-0103-2626",
+		" T 5070001032626 ",
+	}
+	for _, test := range tests {
+		n, err := ParseRegistrationNumber(test)
+		if err != nil {
+			t.Errorf("%q: error! %v", test, err)
+		}
+		if n.CorporateNumber() != CorporateNumber(testFillinCorpNum) {
+			t.Errorf("%q: result:%d expected:%d", test, n, testFillinCorpNum)
+		}
+	}
+}
+
+func TestParseRegistrationNumberError(t *testing.T) {
+	if _, err := ParseRegistrationNumber("5070001032626"); !errors.Is(err, ErrRegistrationNumberPrefix) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+	if _, err := ParseRegistrationNumber(""); !errors.Is(err, ErrRegistrationNumberPrefix) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+
+	var mismatchErr *checkdigit.MismatchError
+	if _, err := ParseRegistrationNumber("T4070001032626"); !errors.As(err, &mismatchErr) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+	var lengthErr *checkdigit.LengthError
+	if _, err := ParseRegistrationNumber("T507000103262"); !errors.As(err, &lengthErr) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+	var digitErr *checkdigit.DigitError
+	if _, err := ParseRegistrationNumber("TT507000103262"); !errors.As(err, &digitErr) || digitErr.Char != 'T' {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
+func TestRegistrationNumberConversion(t *testing.T) {
+	n := CorporateNumber(testFillinCorpNum).RegistrationNumber()
+	if result := n.String(); result != "T5070001032626" {
+		t.Errorf("String is wrong. result:%s", result)
+	}
+	if n.Validate() != nil {
+		t.Errorf("number is invalid. %s", n)
+	}
+	if result := (Corporation{CorporateNumber: testFillinCorpNum}).RegistrationNumber(); result != n {
+		t.Errorf("result:%s expected:%s", result, n)
+	}
+	if result := n.CorporateNumber(); result != CorporateNumber(testFillinCorpNum) {
+		t.Errorf("result:%d", result)
+	}
+	if RegistrationNumber(0).String() != "" {
+		t.Errorf("zero value is wrong. %q", RegistrationNumber(0).String())
+	}
+}
+
+func TestSoleProprietorNumber(t *testing.T) {
+	n, err := ParseSoleProprietorNumber("T7000020100005")
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if n.String() != "T7000020100005" || n.Validate() != nil {
+		t.Errorf("result:%s", n)
+	}
+	if r := n.RegistrationNumber(); r.SoleProprietor() != n {
+		t.Errorf("result:%s", r)
+	}
+	if _, err := ParseSoleProprietorNumber("7000020100005"); !errors.Is(err, ErrRegistrationNumberPrefix) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
+func TestRegistrationNumberJSON(t *testing.T) {
+	type record struct {
+		Corporation    RegistrationNumber   `json:"corporation"`
+		SoleProprietor SoleProprietorNumber `json:"sole_proprietor"`
+	}
+
+	b, err := json.Marshal(record{Corporation: RegistrationNumber(testFillinCorpNum)})
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if string(b) != `{"corporation":"T5070001032626","sole_proprietor":null}` {
+		t.Errorf("JSON is wrong. result:%s", b)
+	}
+
+	var r record
+	if err := json.Unmarshal([]byte(`{"corporation":"T5070001032626","sole_proprietor":"T7000020100005"}`), &r); err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if r.Corporation != RegistrationNumber(testFillinCorpNum) || r.SoleProprietor != SoleProprietorNumber(testGunmaCorpNum) {
+		t.Errorf("result:%+v", r)
+	}
+	for _, test := range []string{`{"corporation":null,"sole_proprietor":null}`, `{"corporation":"","sole_proprietor":""}`} {
+		r := record{Corporation: RegistrationNumber(testFillinCorpNum), SoleProprietor: SoleProprietorNumber(testGunmaCorpNum)}
+		if err := json.Unmarshal([]byte(test), &r); err != nil {
+			t.Errorf("%s: error! %v", test, err)
+		}
+		if r.Corporation != 0 || r.SoleProprietor != 0 {
+			t.Errorf("%s: result:%+v", test, r)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"corporation":"5070001032626"}`), &r); !errors.Is(err, ErrRegistrationNumberPrefix) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"corporation":5070001032626}`), &r); err == nil {
+		t.Error("No error occurred.")
+	}
+}
@@ -0,0 +1,300 @@
+package corp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+//go:generate go run ./internal/cmd/genschema -o schema/corporation.schema.json
+
+/*
+corporationJSON は Corporation の JSON 表現です。
+
+フィールド名は snake_case とし, コード値には日本語・英語の表示用テキストを併記します。
+未設定の日付とコード値は null になります。
+JSON Schema は JSONSchema で生成でき, schema/corporation.schema.json に同梱しています。
+*/
+type corporationJSON struct {
+	SequenceNumber           uint32             `json:"sequence_number" desc:"一連番号"`
+	CorporateNumber          string             `json:"corporate_number" desc:"法人番号(13桁)"`
+	Process                  *codeJSON          `json:"process" desc:"処理区分"`
+	Correct                  bool               `json:"correct" desc:"訂正区分"`
+	UpdateDate               *Date              `json:"update_date" desc:"更新年月日"`
+	ChangeDate               *Date              `json:"change_date" desc:"変更年月日"`
+	Name                     string             `json:"name" desc:"商号又は名称"`
+	NameImageID              string             `json:"name_image_id" desc:"商号又は名称イメージID"`
+	Kind                     *codeJSON          `json:"kind" desc:"法人種別"`
+	Address                  addressJSON        `json:"address" desc:"国内所在地"`
+	AddressOutside           foreignAddressJSON `json:"address_outside" desc:"国外所在地"`
+	Close                    closeJSON          `json:"close" desc:"登記記録の閉鎖等"`
+	SuccessorCorporateNumber *string            `json:"successor_corporate_number" desc:"承継先法人番号(13桁)"`
+	ChangeCause              string             `json:"change_cause" desc:"変更事由の詳細"`
+	AssignmentDate           *Date              `json:"assignment_date" desc:"法人番号指定年月日"`
+	Latest                   bool               `json:"latest" desc:"最新履歴"`
+	En                       englishJSON        `json:"en" desc:"英語表記"`
+	Furigana                 string             `json:"furigana" desc:"フリガナ"`
+	Hihyoji                  bool               `json:"hihyoji" desc:"検索対象除外"`
+}
+
+// codeJSON はコード値と表示用テキストの JSON 表現です。
+type codeJSON struct {
+	Code    string `json:"code" desc:"コード"`
+	Label   string `json:"label" desc:"表示用テキスト(日本語)"`
+	LabelEn string `json:"label_en" desc:"表示用テキスト(英語)"`
+}
+
+// addressJSON は国内所在地の JSON 表現です。
+type addressJSON struct {
+	PrefectureName string  `json:"prefecture_name" desc:"都道府県"`
+	CityName       string  `json:"city_name" desc:"市区町村"`
+	StreetNumber   string  `json:"street_number" desc:"丁目番地等"`
+	PrefectureCode *string `json:"prefecture_code" desc:"都道府県コード(2桁)"`
+	CityCode       *string `json:"city_code" desc:"市区町村コード(3桁)"`
+	PostCode       string  `json:"post_code" desc:"郵便番号(7桁)"`
+	ImageID        string  `json:"image_id" desc:"国内所在地イメージID"`
+}
+
+// foreignAddressJSON は国外所在地の JSON 表現です。
+type foreignAddressJSON struct {
+	Address string `json:"address" desc:"国外所在地"`
+	ImageID string `json:"image_id" desc:"国外所在地イメージID"`
+}
+
+// closeJSON は登記記録の閉鎖等の JSON 表現です。
+type closeJSON struct {
+	Date  *Date     `json:"date" desc:"登記記録の閉鎖等年月日"`
+	Cause *codeJSON `json:"cause" desc:"登記記録の閉鎖等の事由"`
+}
+
+// englishJSON は英語表記の JSON 表現です。
+type englishJSON struct {
+	Name           string `json:"name" desc:"商号又は名称"`
+	PrefectureName string `json:"prefecture_name" desc:"国内所在地(都道府県)"`
+	CityName       string `json:"city_name" desc:"国内所在地(市区町村)"`
+	AddressOutside string `json:"address_outside" desc:"国外所在地"`
+}
+
+// responseJSON は Response の JSON 表現です。
+type responseJSON struct {
+	LastUpdateDate *Date         `json:"last_update_date" desc:"最終更新年月日"`
+	Count          uint32        `json:"count" desc:"総件数"`
+	DivideNumber   uint32        `json:"divide_number" desc:"分割番号"`
+	DivideSize     uint32        `json:"divide_size" desc:"分割数"`
+	Corporations   []Corporation `json:"corporations" desc:"法人情報"`
+}
+
+/*
+MarshalJSON は法人情報を次の形式の JSON に変換します。
+
+	{
+	  "sequence_number": 1,
+	  "corporate_number": "5070001032626",
+	  "process": {"code": "12", "label": "国内所在地の変更", "label_en": "Change of domestic address"},
+	  "correct": false,
+	  "update_date": "2021-06-09",
+	  "change_date": "2021-06-02",
+	  "name": "株式会社フィルイン",
+	  "kind": {"code": "301", "label": "株式会社", "label_en": "Stock company"},
+	  "address": {"prefecture_name": "群馬県", "city_name": "高崎市", "street_number": "飯塚町１４７番地４",
+	    "prefecture_code": "10", "city_code": "202", "post_code": "3700069", "image_id": ""},
+	  "close": {"date": null, "cause": null},
+	  "successor_corporate_number": null,
+	  ...
+	}
+
+表示用テキストは既定の言語によらず日本語と英語を出力します。
+未設定の日付, コード値, 承継先法人番号は null になります。
+*/
+func (c Corporation) MarshalJSON() ([]byte, error) {
+	var corpNum string
+	if c.CorporateNumber != 0 {
+		corpNum = fmt.Sprintf("%013d", c.CorporateNumber)
+	}
+
+	v := corporationJSON{
+		SequenceNumber:  c.SequenceNumber,
+		CorporateNumber: corpNum,
+		Process:         processJSON(c.Process),
+		Correct:         c.Correct,
+		UpdateDate:      nullDate(c.UpdateDate),
+		ChangeDate:      nullDate(c.ChangeDate),
+		Name:            c.Name,
+		NameImageID:     c.NameImageId,
+		Kind:            kindJSON(c.Kind),
+		Address: addressJSON{
+			PrefectureName: c.PrefectureName,
+			CityName:       c.CityName,
+			StreetNumber:   c.StreetNumber,
+			PrefectureCode: paddedCode(uint64(c.PrefectureCode), 2),
+			CityCode:       paddedCode(uint64(c.CityCode), 3),
+			PostCode:       c.PostCode,
+			ImageID:        c.AddressImageId,
+		},
+		AddressOutside: foreignAddressJSON{
+			Address: c.AddressOutside,
+			ImageID: c.AddressOutsideImageId,
+		},
+		Close: closeJSON{
+			Date:  nullDate(c.CloseDate),
+			Cause: closeCauseJSON(c.CloseCause),
+		},
+		SuccessorCorporateNumber: paddedCode(c.SuccessorCorporateNumber, 13),
+		ChangeCause:              c.ChangeCause,
+		AssignmentDate:           nullDate(c.AssignmentDate),
+		Latest:                   c.Latest,
+		En: englishJSON{
+			Name:           c.EnName,
+			PrefectureName: c.EnPrefectureName,
+			CityName:       c.EnCityName,
+			AddressOutside: c.EnAddressOutside,
+		},
+		Furigana: c.Furigana,
+		Hihyoji:  c.Hihyoji,
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON は MarshalJSON の形式の JSON を読み込みます。表示用テキストは無視します。
+func (c *Corporation) UnmarshalJSON(b []byte) error {
+	var v corporationJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	var err error
+	var corp Corporation
+	corp.SequenceNumber = v.SequenceNumber
+	if corp.CorporateNumber, err = parseCode(&v.CorporateNumber, 64); err != nil {
+		return fmt.Errorf("corporate_number: %w", err)
+	}
+	if v.Process != nil {
+		corp.Process = Process(v.Process.Code)
+	}
+	corp.Correct = v.Correct
+	corp.UpdateDate = v.UpdateDate
+	corp.ChangeDate = v.ChangeDate
+	corp.Name = v.Name
+	corp.NameImageId = v.NameImageID
+	if v.Kind != nil {
+		if err := corp.Kind.UnmarshalText([]byte(v.Kind.Code)); err != nil {
+			return fmt.Errorf("kind: %w", err)
+		}
+	}
+
+	corp.PrefectureName = v.Address.PrefectureName
+	corp.CityName = v.Address.CityName
+	corp.StreetNumber = v.Address.StreetNumber
+	prefCode, err := parseCode(v.Address.PrefectureCode, 8)
+	if err != nil {
+		return fmt.Errorf("address.prefecture_code: %w", err)
+	}
+	corp.PrefectureCode = uint8(prefCode)
+	cityCode, err := parseCode(v.Address.CityCode, 16)
+	if err != nil {
+		return fmt.Errorf("address.city_code: %w", err)
+	}
+	corp.CityCode = uint16(cityCode)
+	corp.PostCode = v.Address.PostCode
+	corp.AddressImageId = v.Address.ImageID
+
+	corp.AddressOutside = v.AddressOutside.Address
+	corp.AddressOutsideImageId = v.AddressOutside.ImageID
+	corp.CloseDate = v.Close.Date
+	if v.Close.Cause != nil {
+		corp.CloseCause = CloseCause(v.Close.Cause.Code)
+	}
+	if corp.SuccessorCorporateNumber, err = parseCode(v.SuccessorCorporateNumber, 64); err != nil {
+		return fmt.Errorf("successor_corporate_number: %w", err)
+	}
+	corp.ChangeCause = v.ChangeCause
+	corp.AssignmentDate = v.AssignmentDate
+	corp.Latest = v.Latest
+	corp.EnName = v.En.Name
+	corp.EnPrefectureName = v.En.PrefectureName
+	corp.EnCityName = v.En.CityName
+	corp.EnAddressOutside = v.En.AddressOutside
+	corp.Furigana = v.Furigana
+	corp.Hihyoji = v.Hihyoji
+
+	*c = corp
+	return nil
+}
+
+// MarshalJSON はレスポンスを snake_case のフィールド名の JSON に変換します。
+func (r Response) MarshalJSON() ([]byte, error) {
+	corps := r.Corporations
+	if corps == nil {
+		corps = []Corporation{}
+	}
+	return json.Marshal(responseJSON{
+		LastUpdateDate: nullDate(r.LastUpdateDate),
+		Count:          r.Count,
+		DivideNumber:   r.DivideNumber,
+		DivideSize:     r.DevideSize,
+		Corporations:   corps,
+	})
+}
+
+// UnmarshalJSON は MarshalJSON の形式の JSON を読み込みます。
+func (r *Response) UnmarshalJSON(b []byte) error {
+	var v responseJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*r = Response{
+		LastUpdateDate: v.LastUpdateDate,
+		Count:          v.Count,
+		DivideNumber:   v.DivideNumber,
+		DevideSize:     v.DivideSize,
+		Corporations:   v.Corporations,
+	}
+	return nil
+}
+
+func processJSON(p Process) *codeJSON {
+	if p == "" {
+		return nil
+	}
+	return &codeJSON{string(p), p.Label(LocaleJa), p.Label(LocaleEn)}
+}
+
+func kindJSON(k Kind) *codeJSON {
+	if k == 0 {
+		return nil
+	}
+	return &codeJSON{strconv.FormatUint(uint64(k), 10), k.Label(LocaleJa), k.Label(LocaleEn)}
+}
+
+func closeCauseJSON(c CloseCause) *codeJSON {
+	if c == "" {
+		return nil
+	}
+	return &codeJSON{string(c), c.Label(LocaleJa), c.Label(LocaleEn)}
+}
+
+// nullDate は未設定の日付を nil に変換します。
+func nullDate(d *Date) *Date {
+	if isZeroDate(d) {
+		return nil
+	}
+	return d
+}
+
+// paddedCode は数値のコードを桁数に合わせて 0 埋めした文字列に変換します。0 の場合は nil を返します。
+func paddedCode(n uint64, digits int) *string {
+	if n == 0 {
+		return nil
+	}
+	s := fmt.Sprintf("%0*d", digits, n)
+	return &s
+}
+
+// parseCode は 0 埋めされたコードを数値に変換します。nil または空文字の場合は 0 を返します。
+func parseCode(s *string, bitSize int) (uint64, error) {
+	if s == nil || *s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(*s, 10, bitSize)
+}
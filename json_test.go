@@ -0,0 +1,135 @@
+package corp
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestCorporationMarshalJSON(t *testing.T) {
+	res := testResponse(t, "./testdata/response/diff_search.xml")
+
+	b, err := json.Marshal(res.Corporations[0])
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	expected := `{"sequence_number":5,"corporate_number":"5070001032626",` +
+		`"process":{"code":"12","label":"国内所在地の変更","label_en":"Change of domestic address"},` +
+		`"correct":false,"update_date":"2021-06-09","change_date":"2021-06-02",` +
+		`"name":"株式会社フィルイン","name_image_id":"",` +
+		`"kind":{"code":"301","label":"株式会社","label_en":"Stock company"},` +
+		`"address":{"prefecture_name":"群馬県","city_name":"高崎市","street_number":"飯塚町１４７番地４","prefecture_code":"10","city_code":"202","post_code":"3700069","image_id":""},` +
+		`"address_outside":{"address":"","image_id":""},` +
+		`"close":{"date":null,"cause":null},` +
+		`"successor_corporate_number":null,"change_cause":"","assignment_date":"2016-09-05","latest":true,` +
+		`"en":{"name":"","prefecture_name":"","city_name":"","address_outside":""},` +
+		`"furigana":"フィルイン","hihyoji":false}`
+	if string(b) != expected {
+		t.Errorf("JSON is wrong.\nresult:  %s\nexpected:%s", b, expected)
+	}
+}
+
+func TestCorporationUnmarshalJSON(t *testing.T) {
+	res := testResponse(t, "./testdata/response/diff_search.xml")
+	original := res.Corporations[0]
+	original.CloseCause = CloseCauseMerger
+	original.SuccessorCorporateNumber = testGunmaCorpNum
+
+	b, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	var decoded Corporation
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	if changes := Compare(original, decoded); len(changes) != 0 {
+		t.Errorf("decoded corporation is different.\n%s", changes)
+	}
+
+	if decoded.CorporateNumber != original.CorporateNumber || decoded.Process != original.Process || decoded.SequenceNumber != original.SequenceNumber {
+		t.Errorf("decoded corporation is wrong. result:%+v", decoded)
+	}
+
+	if decoded.UpdateDate.String() != "2021-06-09" || decoded.CloseDate != nil {
+		t.Errorf("decoded dates are wrong. result:%v %v", decoded.UpdateDate, decoded.CloseDate)
+	}
+
+	if err := json.Unmarshal([]byte(`{"corporate_number":"abc"}`), &decoded); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+func TestResponseJSON(t *testing.T) {
+	res := testResponse(t, "./testdata/response/by_numbers.xml")
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	var decoded Response
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	if decoded.Count != res.Count || decoded.DevideSize != res.DevideSize || len(decoded.Corporations) != len(res.Corporations) {
+		t.Errorf("decoded response is wrong. result:%+v", decoded)
+	}
+
+	if decoded.Corporations[1].Name != "群馬県" {
+		t.Errorf("decoded corporation is wrong. result:%s", decoded.Corporations[1].Name)
+	}
+
+	b, _ = json.Marshal(Response{})
+	if string(b) != `{"last_update_date":null,"count":0,"divide_number":0,"divide_size":0,"corporations":[]}` {
+		t.Errorf("empty response JSON is wrong. result:%s", b)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	b, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	shipped, err := os.ReadFile("./schema/corporation.schema.json")
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+
+	if !bytes.Equal(b, shipped) {
+		t.Error("schema/corporation.schema.json is outdated. run go generate.")
+	}
+
+	var schema struct {
+		Defs struct {
+			Corporation struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+				Required   []string                   `json:"required"`
+			} `json:"corporation"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(b, &schema); err != nil {
+		t.Fatalf("failed to parse schema: %v", err)
+	}
+
+	// JSON 表現のすべてのフィールドがスキーマに含まれる
+	res := testResponse(t, "./testdata/response/diff_search.xml")
+	var corp map[string]json.RawMessage
+	b, _ = json.Marshal(res.Corporations[0])
+	_ = json.Unmarshal(b, &corp)
+	for key := range corp {
+		if _, ok := schema.Defs.Corporation.Properties[key]; !ok {
+			t.Errorf("%s is not defined in schema.", key)
+		}
+	}
+
+	if len(schema.Defs.Corporation.Required) != len(corp) {
+		t.Errorf("required length is wrong. result:%d expected:%d", len(schema.Defs.Corporation.Required), len(corp))
+	}
+}
@@ -0,0 +1,349 @@
+package corp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// LegalForm は商号又は名称に含まれる法人格です。値は法人格の正式な表記です。
+type LegalForm string
+
+const (
+	// 株式会社
+	LegalFormStockCompany LegalForm = "株式会社"
+	// 有限会社(特例有限会社)
+	LegalFormLimitedCompany LegalForm = "有限会社"
+	// 合名会社
+	LegalFormGeneralPartnership LegalForm = "合名会社"
+	// 合資会社
+	LegalFormLimitedPartnership LegalForm = "合資会社"
+	// 合同会社
+	LegalFormLLC LegalForm = "合同会社"
+	// 相互会社
+	LegalFormMutualCompany LegalForm = "相互会社"
+	// 一般社団法人
+	LegalFormGeneralAssociation LegalForm = "一般社団法人"
+	// 一般財団法人
+	LegalFormGeneralFoundation LegalForm = "一般財団法人"
+	// 公益社団法人
+	LegalFormPublicInterestAssociation LegalForm = "公益社団法人"
+	// 公益財団法人
+	LegalFormPublicInterestFoundation LegalForm = "公益財団法人"
+	// 医療法人(医療法人社団, 医療法人財団を含む)
+	LegalFormMedical LegalForm = "医療法人"
+	// 特定非営利活動法人
+	LegalFormNPO LegalForm = "特定非営利活動法人"
+	// 社会福祉法人
+	LegalFormSocialWelfare LegalForm = "社会福祉法人"
+	// 学校法人
+	LegalFormSchool LegalForm = "学校法人"
+	// 宗教法人
+	LegalFormReligious LegalForm = "宗教法人"
+	// 独立行政法人
+	LegalFormAdministrativeAgency LegalForm = "独立行政法人"
+	// 地方独立行政法人
+	LegalFormLocalAdministrativeAgency LegalForm = "地方独立行政法人"
+	// 国立大学法人
+	LegalFormNationalUniversity LegalForm = "国立大学法人"
+	// 弁護士法人
+	LegalFormLawFirm LegalForm = "弁護士法人"
+	// 税理士法人
+	LegalFormTaxAccountant LegalForm = "税理士法人"
+	// 監査法人
+	LegalFormAuditFirm LegalForm = "監査法人"
+	// 司法書士法人
+	LegalFormJudicialScrivener LegalForm = "司法書士法人"
+	// 行政書士法人
+	LegalFormAdministrativeScrivener LegalForm = "行政書士法人"
+	// 社会保険労務士法人
+	LegalFormLaborConsultant LegalForm = "社会保険労務士法人"
+	// 特許業務法人
+	LegalFormPatentFirm LegalForm = "特許業務法人"
+	// 協同組合(事業協同組合, 農業協同組合などを含む)
+	LegalFormCooperative LegalForm = "協同組合"
+	// 企業組合
+	LegalFormEnterpriseAssociation LegalForm = "企業組合"
+	// 農事組合法人
+	LegalFormAgricultural LegalForm = "農事組合法人"
+	// 信用金庫
+	LegalFormShinkin LegalForm = "信用金庫"
+	// 管理組合法人
+	LegalFormManagementAssociation LegalForm = "管理組合法人"
+)
+
+// LegalFormPosition は商号又は名称の中の法人格の位置です。
+type LegalFormPosition uint8
+
+const (
+	// 法人格を含まない
+	LegalFormNone LegalFormPosition = iota
+	// 名称の前(例: 株式会社フィルイン)
+	LegalFormPrefix
+	// 名称の後(例: フィルイン株式会社)
+	LegalFormSuffix
+)
+
+// legalFormSpec は法人格の表記と対応する法人種別です。
+type legalFormSpec struct {
+	form LegalForm
+	// 正式な表記の別表記(例: 医療法人社団)
+	aliases []string
+	// 略称(先頭を代表とする)
+	abbreviations []string
+	// 対応する法人種別
+	kinds []Kind
+}
+
+var (
+	// 会社以外の法人格に対応する法人種別
+	otherKinds = []Kind{KindOtherRegistered, KindOther}
+
+	legalForms = []legalFormSpec{
+		{LegalFormStockCompany, nil, []string{"(株)", "㈱"}, companyKinds(KindStockCompany)},
+		{LegalFormLimitedCompany, nil, []string{"(有)", "㈲"}, companyKinds(KindLimitedCompany)},
+		{LegalFormGeneralPartnership, nil, []string{"(名)", "㈴"}, companyKinds(KindGeneralPartnership)},
+		{LegalFormLimitedPartnership, nil, []string{"(資)", "㈾"}, companyKinds(KindLimitedPartnership)},
+		{LegalFormLLC, nil, []string{"(同)"}, companyKinds(KindLLC)},
+		{LegalFormMutualCompany, nil, nil, otherKinds},
+		{LegalFormGeneralAssociation, nil, []string{"(一社)"}, otherKinds},
+		{LegalFormGeneralFoundation, nil, []string{"(一財)"}, otherKinds},
+		{LegalFormPublicInterestAssociation, nil, []string{"(公社)"}, otherKinds},
+		{LegalFormPublicInterestFoundation, nil, []string{"(公財)"}, otherKinds},
+		{LegalFormMedical, []string{"医療法人社団", "医療法人財団"}, []string{"(医)"}, otherKinds},
+		{LegalFormNPO, nil, []string{"(特非)", "NPO法人"}, otherKinds},
+		{LegalFormSocialWelfare, nil, []string{"(福)"}, otherKinds},
+		{LegalFormSchool, nil, []string{"(学)", "㈻"}, otherKinds},
+		{LegalFormReligious, nil, []string{"(宗)"}, otherKinds},
+		{LegalFormAdministrativeAgency, nil, []string{"(独)"}, otherKinds},
+		{LegalFormLocalAdministrativeAgency, nil, []string{"(地独)"}, otherKinds},
+		{LegalFormNationalUniversity, nil, nil, otherKinds},
+		{LegalFormLawFirm, nil, []string{"(弁)"}, otherKinds},
+		{LegalFormTaxAccountant, nil, []string{"(税)"}, otherKinds},
+		{LegalFormAuditFirm, nil, []string{"(監)", "㈼"}, otherKinds},
+		{LegalFormJudicialScrivener, nil, []string{"(司)"}, otherKinds},
+		{LegalFormAdministrativeScrivener, nil, []string{"(行)"}, otherKinds},
+		{LegalFormLaborConsultant, nil, nil, otherKinds},
+		{LegalFormPatentFirm, nil, nil, otherKinds},
+		{LegalFormCooperative, []string{"事業協同組合", "農業協同組合", "漁業協同組合", "生活協同組合", "消費生活協同組合"}, []string{"(協)", "㈿"}, otherKinds},
+		{LegalFormEnterpriseAssociation, nil, []string{"(企)", "㈽"}, otherKinds},
+		{LegalFormAgricultural, nil, []string{"(農)"}, otherKinds},
+		{LegalFormShinkin, nil, nil, otherKinds},
+		{LegalFormManagementAssociation, nil, nil, otherKinds},
+	}
+
+	// 表記から法人格への対応(長い表記から順に照合する)
+	legalFormNotations []legalFormNotation
+)
+
+type legalFormNotation struct {
+	text        string
+	spec        *legalFormSpec
+	abbreviated bool
+}
+
+func init() {
+	for i := range legalForms {
+		spec := &legalForms[i]
+		legalFormNotations = append(legalFormNotations, legalFormNotation{string(spec.form), spec, false})
+		for _, alias := range spec.aliases {
+			legalFormNotations = append(legalFormNotations, legalFormNotation{alias, spec, false})
+		}
+		for _, abbr := range spec.abbreviations {
+			legalFormNotations = append(legalFormNotations, legalFormNotation{abbr, spec, true})
+		}
+	}
+	// 「地方独立行政法人」を「独立行政法人」より先に照合する
+	sort.SliceStable(legalFormNotations, func(i, j int) bool {
+		return len(legalFormNotations[i].text) > len(legalFormNotations[j].text)
+	})
+}
+
+// companyKinds は会社の法人格に対応する法人種別を返します。外国会社等も含みます。
+func companyKinds(k Kind) []Kind {
+	return []Kind{k, KindForeignCompany}
+}
+
+// String は法人格の正式な表記を返します。
+func (f LegalForm) String() string {
+	return string(f)
+}
+
+// IsValid は既知の法人格か判定します。
+func (f LegalForm) IsValid() bool {
+	return f.spec() != nil
+}
+
+// Abbreviation は法人格の略称(例: (株))を返します。略称がない場合は空文字を返します。
+func (f LegalForm) Abbreviation() string {
+	if s := f.spec(); s != nil && len(s.abbreviations) > 0 {
+		return s.abbreviations[0]
+	}
+	return ""
+}
+
+// Kinds は法人格に対応する法人種別を返します。
+func (f LegalForm) Kinds() []Kind {
+	if s := f.spec(); s != nil {
+		return append([]Kind{}, s.kinds...)
+	}
+	return nil
+}
+
+// IsCompany は会社法上の会社(株式会社, 有限会社, 合名会社, 合資会社, 合同会社)か判定します。
+func (f LegalForm) IsCompany() bool {
+	switch f {
+	case LegalFormStockCompany, LegalFormLimitedCompany, LegalFormGeneralPartnership, LegalFormLimitedPartnership, LegalFormLLC:
+		return true
+	}
+	return false
+}
+
+func (f LegalForm) spec() *legalFormSpec {
+	for i := range legalForms {
+		if legalForms[i].form == f {
+			return &legalForms[i]
+		}
+	}
+	return nil
+}
+
+// ParsedName は商号又は名称を法人格と法人格を除いた名称に分けた結果です。
+type ParsedName struct {
+	// 法人格
+	// 法人格を含まない場合は空文字
+	LegalForm LegalForm
+	// 法人格の位置
+	Position LegalFormPosition
+	// 名称中の法人格の表記(例: 株式会社, (株), 医療法人社団)
+	Notation string
+	// 法人格を除いた名称(例: フィルイン)
+	Core string
+}
+
+/*
+ParseName は商号又は名称を法人格と法人格を除いた名称に分けます。
+
+名称の前または後にある法人格の正式な表記と, (株), ㈱ などの略称に対応します。
+全角の括弧や空白は半角として扱い, 法人格と名称の間の空白は取り除きます。
+
+	ParseName("株式会社フィルイン") // {LegalForm: 株式会社, Position: LegalFormPrefix, Notation: 株式会社, Core: フィルイン}
+	ParseName("フィルイン（株）")   // {LegalForm: 株式会社, Position: LegalFormSuffix, Notation: (株), Core: フィルイン}
+
+法人格を含まない場合は Core に名称全体を設定します。
+*/
+func ParseName(name string) ParsedName {
+	name = normalize.Profile{Width: true, Spaces: normalize.SpacesCollapse}.Normalize(name)
+
+	for _, n := range legalFormNotations {
+		if strings.HasPrefix(name, n.text) {
+			if core := strings.TrimSpace(strings.TrimPrefix(name, n.text)); core != "" {
+				return ParsedName{LegalForm: n.spec.form, Position: LegalFormPrefix, Notation: n.text, Core: core}
+			}
+		}
+	}
+	for _, n := range legalFormNotations {
+		if strings.HasSuffix(name, n.text) {
+			if core := strings.TrimSpace(strings.TrimSuffix(name, n.text)); core != "" {
+				return ParsedName{LegalForm: n.spec.form, Position: LegalFormSuffix, Notation: n.text, Core: core}
+			}
+		}
+	}
+	return ParsedName{Core: name}
+}
+
+// Abbreviated は名称中の法人格の表記が略称か判定します。
+func (p ParsedName) Abbreviated() bool {
+	for _, n := range legalFormNotations {
+		if n.text == p.Notation {
+			return n.abbreviated
+		}
+	}
+	return false
+}
+
+// FullName は法人格を正式な表記にした商号又は名称(例: 株式会社フィルイン)を返します。
+func (p ParsedName) FullName() string {
+	return p.join(p.LegalForm.String())
+}
+
+/*
+Abbreviations は法人格を略称にした商号又は名称を返します。
+
+	ParseName("株式会社フィルイン").Abbreviations() // [(株)フィルイン ㈱フィルイン]
+
+法人格を含まない場合や法人格に略称がない場合は空です。
+*/
+func (p ParsedName) Abbreviations() []string {
+	s := p.LegalForm.spec()
+	if s == nil || p.Position == LegalFormNone {
+		return nil
+	}
+	var list []string
+	for _, abbr := range s.abbreviations {
+		list = append(list, p.join(abbr))
+	}
+	return list
+}
+
+func (p ParsedName) join(form string) string {
+	switch p.Position {
+	case LegalFormPrefix:
+		return form + p.Core
+	case LegalFormSuffix:
+		return p.Core + form
+	}
+	return p.Core
+}
+
+// ParsedName は商号又は名称(Name)を ParseName で法人格と名称に分けます。
+func (c Corporation) ParsedName() ParsedName {
+	return ParseName(c.Name)
+}
+
+// LegalFormMismatchError は商号又は名称の法人格と法人種別が一致しない場合のエラーです。
+type LegalFormMismatchError struct {
+	// 法人番号
+	CorporateNumber uint64
+	// 法人種別
+	Kind Kind
+	// 商号又は名称の法人格
+	// 法人格を含まない場合は空文字
+	LegalForm LegalForm
+}
+
+func (e *LegalFormMismatchError) Error() string {
+	if e.LegalForm == "" {
+		return fmt.Sprintf("%013d: name has no legal form for kind %d", e.CorporateNumber, e.Kind)
+	}
+	return fmt.Sprintf("%013d: legal form %q does not match kind %d", e.CorporateNumber, e.LegalForm, e.Kind)
+}
+
+/*
+ValidateLegalForm は商号又は名称の法人格と法人種別(Kind)が一致するか検証します。
+
+一致しない場合は *LegalFormMismatchError を返します。
+法人種別が会社(301〜305)で名称に法人格を含まない場合も一致しないものとします。
+法人種別がない場合, 国の機関, 地方公共団体などで名称に法人格を含まない場合は検証しません。
+*/
+func (c Corporation) ValidateLegalForm() error {
+	if c.Kind == 0 {
+		return nil
+	}
+	p := c.ParsedName()
+	if p.LegalForm == "" {
+		switch c.Kind {
+		case KindStockCompany, KindLimitedCompany, KindGeneralPartnership, KindLimitedPartnership, KindLLC:
+			return &LegalFormMismatchError{CorporateNumber: c.CorporateNumber, Kind: c.Kind}
+		}
+		return nil
+	}
+	for _, k := range p.LegalForm.Kinds() {
+		if k == c.Kind {
+			return nil
+		}
+	}
+	return &LegalFormMismatchError{CorporateNumber: c.CorporateNumber, Kind: c.Kind, LegalForm: p.LegalForm}
+}
@@ -0,0 +1,106 @@
+package corp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseName(t *testing.T) {
+	tests := map[string]ParsedName{
+		"株式会社フィルイン":       {LegalFormStockCompany, LegalFormPrefix, "株式会社", "フィルイン"},
+		"フィルイン株式会社":       {LegalFormStockCompany, LegalFormSuffix, "株式会社", "フィルイン"},
+		"株式会社　フィルイン":      {LegalFormStockCompany, LegalFormPrefix, "株式会社", "フィルイン"},
+		"（株）フィルイン":        {LegalFormStockCompany, LegalFormPrefix, "(株)", "フィルイン"},
+		"フィルイン㈱":          {LegalFormStockCompany, LegalFormSuffix, "㈱", "フィルイン"},
+		"医療法人社団健康会":       {LegalFormMedical, LegalFormPrefix, "医療法人社団", "健康会"},
+		"地方独立行政法人群馬病院":    {LegalFormLocalAdministrativeAgency, LegalFormPrefix, "地方独立行政法人", "群馬病院"},
+		"特定非営利活動法人高崎の森":   {LegalFormNPO, LegalFormPrefix, "特定非営利活動法人", "高崎の森"},
+		"NPO法人高崎の森":       {LegalFormNPO, LegalFormPrefix, "NPO法人", "高崎の森"},
+		"群馬県農業協同組合":       {LegalFormCooperative, LegalFormSuffix, "農業協同組合", "群馬県"},
+		"一般社団法人日本フィルイン協会": {LegalFormGeneralAssociation, LegalFormPrefix, "一般社団法人", "日本フィルイン協会"},
+		"群馬県":  {Core: "群馬県"},
+		"株式会社": {Core: "株式会社"},
+	}
+
+	for name, expected := range tests {
+		if result := ParseName(name); result != expected {
+			t.Errorf("%s: result:%+v expected:%+v", name, result, expected)
+		}
+	}
+}
+
+func TestParsedName(t *testing.T) {
+	p := ParseName("株式会社フィルイン")
+	if p.Abbreviated() || p.FullName() != "株式会社フィルイン" {
+		t.Errorf("result is wrong. %+v", p)
+	}
+	if result := p.Abbreviations(); !reflect.DeepEqual(result, []string{"(株)フィルイン", "㈱フィルイン"}) {
+		t.Errorf("Abbreviations is wrong. result:%v", result)
+	}
+
+	p = ParseName("フィルイン(有)")
+	if !p.Abbreviated() || p.FullName() != "フィルイン有限会社" {
+		t.Errorf("result is wrong. %+v", p)
+	}
+	if result := p.Abbreviations(); !reflect.DeepEqual(result, []string{"フィルイン(有)", "フィルイン㈲"}) {
+		t.Errorf("Abbreviations is wrong. result:%v", result)
+	}
+
+	if result := ParseName("群馬県").Abbreviations(); result != nil {
+		t.Errorf("Abbreviations is wrong. result:%v", result)
+	}
+	if result := ParseName("国立大学法人群馬大学").Abbreviations(); result != nil {
+		t.Errorf("Abbreviations is wrong. result:%v", result)
+	}
+}
+
+func TestLegalForm(t *testing.T) {
+	if !LegalFormStockCompany.IsValid() || LegalForm("株式").IsValid() {
+		t.Error("IsValid is wrong.")
+	}
+	if LegalFormStockCompany.Abbreviation() != "(株)" || LegalFormPatentFirm.Abbreviation() != "" {
+		t.Error("Abbreviation is wrong.")
+	}
+	if !reflect.DeepEqual(LegalFormLLC.Kinds(), []Kind{KindLLC, KindForeignCompany}) {
+		t.Errorf("Kinds is wrong. result:%v", LegalFormLLC.Kinds())
+	}
+	if !LegalFormLLC.IsCompany() || LegalFormMedical.IsCompany() {
+		t.Error("IsCompany is wrong.")
+	}
+}
+
+func TestValidateLegalForm(t *testing.T) {
+	tests := map[string]struct {
+		corp     Corporation
+		valid    bool
+		expected LegalForm
+	}{
+		"stock company":    {Corporation{Name: "株式会社フィルイン", Kind: KindStockCompany}, true, ""},
+		"other registered": {Corporation{Name: "医療法人社団健康会", Kind: KindOtherRegistered}, true, ""},
+		"local government": {Corporation{Name: "群馬県", Kind: KindLocalGovernment}, true, ""},
+		"no kind":          {Corporation{Name: "株式会社フィルイン"}, true, ""},
+		"mismatch":         {Corporation{Name: "合同会社フィルイン", Kind: KindStockCompany}, false, LegalFormLLC},
+		"company in 399":   {Corporation{Name: "株式会社フィルイン", Kind: KindOtherRegistered}, false, LegalFormStockCompany},
+		"no legal form":    {Corporation{Name: "フィルイン", Kind: KindStockCompany}, false, ""},
+	}
+
+	for name, tt := range tests {
+		err := tt.corp.ValidateLegalForm()
+		if tt.valid {
+			if err != nil {
+				t.Errorf("%s: error occurred. %v", name, err)
+			}
+			continue
+		}
+
+		var mismatch *LegalFormMismatchError
+		if !errors.As(err, &mismatch) {
+			t.Errorf("%s: error is wrong. result:%v", name, err)
+			continue
+		}
+		if mismatch.LegalForm != tt.expected {
+			t.Errorf("%s: legal form is wrong. result:%s expected:%s", name, mismatch.LegalForm, tt.expected)
+		}
+	}
+}
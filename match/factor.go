@@ -0,0 +1,220 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	corp "github.com/fillin-inc/go-corp"
+	"github.com/fillin-inc/go-corp/address"
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// FactorType はスコアの要素の種類です。
+type FactorType string
+
+const (
+	// 商号又は名称の類似度
+	FactorName FactorType = "name"
+	// 法人格の一致
+	FactorLegalForm FactorType = "legal_form"
+	// 所在地の一致
+	FactorAddress FactorType = "address"
+	// 郵便番号の一致
+	FactorPostCode FactorType = "post_code"
+	// 法人種別の一致
+	FactorKind FactorType = "kind"
+	// 登記記録の閉鎖(減点)
+	FactorClosed FactorType = "closed"
+	// 最新履歴でない(減点)
+	FactorNotLatest FactorType = "not_latest"
+	// 検索対象除外(減点)
+	FactorHihyoji FactorType = "hihyoji"
+)
+
+func init() {
+	corp.RegisterCatalog(corp.LocaleJa, corp.Catalog{
+		"match.score":             "スコア",
+		"match.factor.name":       "名称の類似度",
+		"match.factor.legal_form": "法人格の一致",
+		"match.factor.address":    "所在地の一致",
+		"match.factor.post_code":  "郵便番号の一致",
+		"match.factor.kind":       "法人種別の一致",
+		"match.factor.closed":     "登記記録の閉鎖",
+		"match.factor.not_latest": "最新履歴でない",
+		"match.factor.hihyoji":    "検索対象除外",
+	})
+	corp.RegisterCatalog(corp.LocaleEn, corp.Catalog{
+		"match.score":             "Score",
+		"match.factor.name":       "Name similarity",
+		"match.factor.legal_form": "Legal form",
+		"match.factor.address":    "Address",
+		"match.factor.post_code":  "Post code",
+		"match.factor.kind":       "Kind",
+		"match.factor.closed":     "Closed",
+		"match.factor.not_latest": "Not latest",
+		"match.factor.hihyoji":    "Excluded from search",
+	})
+}
+
+// Factor はスコアの 1 要素です。
+type Factor struct {
+	// 要素の種類
+	Type FactorType
+	// 重み(減点の要素は負の値)
+	Weight float64
+	// 0 から 1 の一致の度合い
+	Similarity float64
+	// 比較した候補の値(例: 正規化した名称)
+	Detail string
+}
+
+/*
+Format は指定した言語で要素を表示用テキストに変換します。
+
+	名称の類似度: 1.00 x 6.00 (フィルイン)
+	登記記録の閉鎖: -0.15
+*/
+func (f Factor) Format(l corp.Locale) string {
+	label := corp.Translate(l, "match.factor."+string(f.Type))
+	if f.Weight < 0 {
+		return fmt.Sprintf("%s: %.2f", label, f.Weight)
+	}
+	s := fmt.Sprintf("%s: %.2f x %.2f", label, f.Similarity, f.Weight)
+	if f.Detail != "" {
+		s += " (" + f.Detail + ")"
+	}
+	return s
+}
+
+// String は既定の言語で Format の結果を返します。
+func (f Factor) String() string {
+	return f.Format(corp.CurrentLocale())
+}
+
+// EnString は英語で Format の結果を返します。
+func (f Factor) EnString() string {
+	return f.Format(corp.LocaleEn)
+}
+
+/*
+nameSimilarity は法人格を除いた名称の類似度を返します。
+
+商号又は名称, フリガナ, 英語表記のうち最も類似度の高いものを採用します。
+*/
+func nameSimilarity(q query, c corp.Corporation) (float64, string) {
+	if q.core == "" {
+		return 0, ""
+	}
+	best, detail := 0.0, ""
+	for _, name := range []string{
+		normalize.LooseProfile.Normalize(c.ParsedName().Core),
+		normalize.LooseProfile.Normalize(c.Furigana),
+		normalize.LooseProfile.Normalize(c.EnName),
+	} {
+		if name == "" {
+			continue
+		}
+		if s := similarity(q.core, name); s > best {
+			best, detail = s, name
+		}
+	}
+	return best, detail
+}
+
+/*
+addressSimilarity は所在地の一致の度合いを返します。
+
+都道府県, 市区町村, 町名, 丁目番地等をそれぞれ比較し, 入力された部分のうち一致した割合を返します。
+都道府県を省略した所在地(例: 高崎市八島町58-1)にも対応します。
+*/
+func addressSimilarity(addr string, c corp.Corporation) (float64, string) {
+	pref := address.Normalize(c.PrefectureName)
+	city := address.Normalize(c.CityName)
+	street := address.ParseStreet(c.StreetNumber)
+
+	var matched, total float64
+	check := func(weight float64, ok bool) {
+		total += weight
+		if ok {
+			matched += weight
+		}
+	}
+
+	rest := addr
+	if hasPrefecture(rest) {
+		ok := pref != "" && strings.HasPrefix(rest, pref)
+		check(1, ok)
+		if ok {
+			rest = strings.TrimPrefix(rest, pref)
+		}
+	}
+	if city != "" && strings.HasPrefix(rest, city) {
+		check(2, true)
+		rest = strings.TrimPrefix(rest, city)
+	} else {
+		check(2, false)
+	}
+
+	qs := address.ParseStreet(rest)
+	if qs.Town != "" {
+		check(2, qs.Town == street.Town)
+	}
+	if n := qs.Number(); n != "" {
+		check(2, n == street.Number())
+	}
+
+	detail := address.Address{Prefecture: c.PrefectureName, City: c.CityName, Street: c.StreetNumber}.Normalized().String()
+	return matched / total, detail
+}
+
+func hasPrefecture(s string) bool {
+	for _, p := range address.Prefectures() {
+		if strings.HasPrefix(s, p.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// similarity はレーベンシュタイン距離による 0 から 1 の類似度を返します。
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	n := len(ra)
+	if len(rb) > n {
+		n = len(rb)
+	}
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
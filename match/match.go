@@ -0,0 +1,251 @@
+/*
+入力された会社名・所在地と法人情報を照合するパッケージです。
+
+商号又は名称による検索(NameSearch)の結果から, 利用者が入力した会社名や所在地に最も一致する法人を選ぶために使用します。
+各候補を名称の類似度, 法人格, 所在地, 郵便番号, 法人種別, 最新履歴・閉鎖・検索対象除外の状態で採点し,
+スコアの高い順に並べて返します。各スコアの内訳は Factor で確認できます。
+
+	res, err := corp.NameSearch("フィルイン", "10202")
+	if err != nil {
+		return err
+	}
+	results := match.Match(match.Query{Name: "フィルイン株式会社", Address: "群馬県高崎市八島町58-1"}, res.Corporations)
+	if best, ok := results.Best(); ok {
+		fmt.Println(best.Corporation.CorporateNumber)
+	}
+*/
+package match
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corp "github.com/fillin-inc/go-corp"
+	"github.com/fillin-inc/go-corp/address"
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// Query は照合する会社の情報です。Name 以外は省略できます。
+type Query struct {
+	// 商号又は名称(例: フィルイン株式会社, (株)フィルイン)
+	Name string
+	// 所在地(例: 群馬県高崎市八島町58-1)
+	Address string
+	// 郵便番号
+	PostCode string
+	// 法人種別
+	Kind corp.Kind
+}
+
+/*
+Weights は各要素の重みです。
+
+Name, LegalForm, Address, PostCode, Kind は照合に使用した要素の重みで加重平均し,
+Closed, NotLatest, Hihyoji は該当する場合にスコアから差し引きます。
+*/
+type Weights struct {
+	Name      float64
+	LegalForm float64
+	Address   float64
+	PostCode  float64
+	Kind      float64
+	Closed    float64
+	NotLatest float64
+	Hihyoji   float64
+}
+
+// DefaultWeights は既定の重みです。
+var DefaultWeights = Weights{
+	Name:      6,
+	LegalForm: 1,
+	Address:   3,
+	PostCode:  1,
+	Kind:      1,
+	Closed:    0.15,
+	NotLatest: 0.1,
+	Hihyoji:   0.05,
+}
+
+// Confidence は照合結果の確からしさです。
+type Confidence uint8
+
+const (
+	// 低い
+	ConfidenceLow Confidence = iota
+	// 中程度
+	ConfidenceMedium
+	// 高い
+	ConfidenceHigh
+)
+
+var confidenceNames = map[Confidence]string{
+	ConfidenceLow:    "low",
+	ConfidenceMedium: "medium",
+	ConfidenceHigh:   "high",
+}
+
+// String は確からしさの名称(low, medium, high)を返します。
+func (c Confidence) String() string {
+	return confidenceNames[c]
+}
+
+// 確からしさのスコアの下限
+const (
+	highScore   = 0.85
+	mediumScore = 0.6
+	// Best で最も高いスコアとみなす 2 番目の候補との差
+	bestMargin = 0.05
+)
+
+// Matcher は重みを指定して照合します。ゼロ値は DefaultWeights を使用します。
+type Matcher struct {
+	Weights Weights
+}
+
+// Match は DefaultWeights で照合します。
+func Match(q Query, candidates []corp.Corporation) Results {
+	return Matcher{}.Match(q, candidates)
+}
+
+/*
+Match は候補の法人情報をそれぞれ採点し, スコアの高い順に返します。
+
+スコアが同じ場合は候補の順序を保ちます。
+*/
+func (m Matcher) Match(q Query, candidates []corp.Corporation) Results {
+	w := m.Weights
+	if w == (Weights{}) {
+		w = DefaultWeights
+	}
+
+	query := parseQuery(q)
+	results := make(Results, 0, len(candidates))
+	for _, c := range candidates {
+		results = append(results, score(query, c, w))
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// Result は 1 件の候補の照合結果です。
+type Result struct {
+	Corporation corp.Corporation
+	// 0 から 1 のスコア
+	Score float64
+	// 確からしさ
+	Confidence Confidence
+	// スコアの内訳
+	Factors []Factor
+}
+
+// Results は照合結果の一覧です。
+type Results []Result
+
+/*
+Best は最もスコアの高い候補を返します。
+
+確からしさが高く, 2 番目の候補とのスコアの差が 0.05 以上ある場合のみ返します。
+それ以外の場合は候補を特定できないものとして false を返します。
+*/
+func (rs Results) Best() (Result, bool) {
+	if len(rs) == 0 || rs[0].Confidence != ConfidenceHigh {
+		return Result{}, false
+	}
+	if len(rs) > 1 && rs[0].Score-rs[1].Score < bestMargin {
+		return Result{}, false
+	}
+	return rs[0], true
+}
+
+// Explain は指定した言語でスコアの内訳を 1 行 1 要素の表示用テキストに変換します。
+func (r Result) Explain(l corp.Locale) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %.2f (%s)\n", corp.Translate(l, "match.score"), r.Score, r.Confidence)
+	for _, f := range r.Factors {
+		b.WriteString(f.Format(l) + "\n")
+	}
+	return b.String()
+}
+
+type query struct {
+	Query
+	parsed corp.ParsedName
+	core   string
+	addr   string
+}
+
+func parseQuery(q Query) query {
+	parsed := corp.ParseName(q.Name)
+	return query{
+		Query:  q,
+		parsed: parsed,
+		core:   normalize.LooseProfile.Normalize(parsed.Core),
+		addr:   address.Normalize(q.Address),
+	}
+}
+
+func score(q query, c corp.Corporation, w Weights) Result {
+	var factors []Factor
+	var total, weights float64
+	add := func(t FactorType, weight, similarity float64, detail string) {
+		total += weight * similarity
+		weights += weight
+		factors = append(factors, Factor{Type: t, Weight: weight, Similarity: similarity, Detail: detail})
+	}
+
+	sim, detail := nameSimilarity(q, c)
+	add(FactorName, w.Name, sim, detail)
+
+	if p := c.ParsedName(); q.parsed.LegalForm != "" && p.LegalForm != "" {
+		add(FactorLegalForm, w.LegalForm, boolScore(q.parsed.LegalForm == p.LegalForm), string(p.LegalForm))
+	}
+	if q.addr != "" {
+		sim, detail := addressSimilarity(q.addr, c)
+		add(FactorAddress, w.Address, sim, detail)
+	}
+	if code := address.NormalizePostCode(q.PostCode); code != "" {
+		add(FactorPostCode, w.PostCode, boolScore(code == address.NormalizePostCode(c.PostCode)), c.PostCode)
+	}
+	if q.Kind != 0 {
+		add(FactorKind, w.Kind, boolScore(q.Kind == c.Kind), c.Kind.Label(corp.CurrentLocale()))
+	}
+
+	s := 0.0
+	if weights > 0 {
+		s = total / weights
+	}
+	penalty := func(t FactorType, weight float64, applies bool) {
+		if applies && weight > 0 {
+			s -= weight
+			factors = append(factors, Factor{Type: t, Weight: -weight, Similarity: 1})
+		}
+	}
+	penalty(FactorClosed, w.Closed, c.Closed())
+	penalty(FactorNotLatest, w.NotLatest, !c.Latest)
+	penalty(FactorHihyoji, w.Hihyoji, c.Hihyoji)
+
+	if s < 0 {
+		s = 0
+	}
+	return Result{Corporation: c, Score: s, Confidence: confidenceOf(s), Factors: factors}
+}
+
+func confidenceOf(s float64) Confidence {
+	switch {
+	case s >= highScore:
+		return ConfidenceHigh
+	case s >= mediumScore:
+		return ConfidenceMedium
+	}
+	return ConfidenceLow
+}
+
+func boolScore(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+package match
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	corp "github.com/fillin-inc/go-corp"
+)
+
+func testCandidates() []corp.Corporation {
+	closeDate := corp.Date(time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC))
+	return []corp.Corporation{
+		{CorporateNumber: 1, Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: corp.KindStockCompany, PrefectureName: "群馬県", CityName: "高崎市", StreetNumber: "八島町５８番地１", PostCode: "3700849", Latest: true},
+		{CorporateNumber: 2, Name: "フィルイン合同会社", Furigana: "フィルイン", Kind: corp.KindLLC, PrefectureName: "東京都", CityName: "千代田区", StreetNumber: "丸の内１丁目１番１号", PostCode: "1000005", Latest: true},
+		{CorporateNumber: 3, Name: "株式会社フィルインテック", Furigana: "フィルインテック", Kind: corp.KindStockCompany, PrefectureName: "群馬県", CityName: "前橋市", StreetNumber: "大手町１丁目１番１号", Latest: true},
+		{CorporateNumber: 4, Name: "株式会社フィルイン", Furigana: "フィルイン", Kind: corp.KindStockCompany, PrefectureName: "群馬県", CityName: "高崎市", StreetNumber: "八島町５８番地１", CloseDate: &closeDate, Latest: true},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	results := Match(Query{Name: "（株）フィルイン", Address: "高崎市八島町58-1"}, testCandidates())
+	if len(results) != 4 {
+		t.Fatalf("length is wrong. result:%d", len(results))
+	}
+	var order []uint64
+	for _, r := range results {
+		order = append(order, r.Corporation.CorporateNumber)
+	}
+	if order[0] != 1 || order[1] != 4 {
+		t.Errorf("order is wrong. result:%v", order)
+	}
+	if results[0].Score != 1 || results[0].Confidence != ConfidenceHigh {
+		t.Errorf("score is wrong. result:%v %s", results[0].Score, results[0].Confidence)
+	}
+
+	best, ok := results.Best()
+	if !ok || best.Corporation.CorporateNumber != 1 {
+		t.Errorf("best is wrong. %+v", best)
+	}
+}
+
+func TestMatchDecodedCorporation(t *testing.T) {
+	// 閉鎖されていない法人も閉鎖等年月日は空の要素で返される
+	var res corp.Response
+	err := xml.Unmarshal([]byte(`<corporations><corporation><corporateNumber>5070001032626</corporateNumber><process>01</process><name>株式会社フィルイン</name><kind>301</kind><prefectureName>群馬県</prefectureName><cityName>高崎市</cityName><streetNumber>八島町５８番地１</streetNumber><closeDate /><latest>1</latest></corporation></corporations>`), &res)
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if res.Corporations[0].CloseDate == nil {
+		t.Fatal("closeDate is not decoded.")
+	}
+
+	results := Match(Query{Name: "株式会社フィルイン", Address: "群馬県高崎市八島町58-1"}, res.Corporations)
+	if results[0].Score != 1 || results[0].Confidence != ConfidenceHigh {
+		t.Errorf("score is wrong. result:%v %s", results[0].Score, results[0].Confidence)
+	}
+	for _, f := range results[0].Factors {
+		if f.Type == FactorClosed {
+			t.Errorf("closed penalty is applied. %+v", results[0].Factors)
+		}
+	}
+}
+
+func TestMatchLegalFormAndKind(t *testing.T) {
+	results := Match(Query{Name: "フィルイン合同会社", Kind: corp.KindLLC}, testCandidates())
+	if results[0].Corporation.CorporateNumber != 2 {
+		t.Errorf("best is wrong. result:%d", results[0].Corporation.CorporateNumber)
+	}
+
+	// 法人格と法人種別がなければ同名の候補を区別できない
+	results = Match(Query{Name: "フィルイン"}, testCandidates()[:2])
+	if _, ok := results.Best(); ok {
+		t.Error("best is found.")
+	}
+}
+
+func TestMatchFurigana(t *testing.T) {
+	results := Match(Query{Name: "ふぃるいんてっく"}, testCandidates())
+	if results[0].Corporation.CorporateNumber != 3 || results[0].Score != 1 {
+		t.Errorf("best is wrong. %+v", results[0])
+	}
+}
+
+func TestMatchPostCode(t *testing.T) {
+	m := Matcher{Weights: Weights{Name: 1, PostCode: 1}}
+	results := m.Match(Query{Name: "フィルイン", PostCode: "〒370-0849"}, testCandidates()[:2])
+	if results[0].Corporation.CorporateNumber != 1 || results[1].Score != 0.5 {
+		t.Errorf("result is wrong. %v %v", results[0].Score, results[1].Score)
+	}
+}
+
+func TestMatchAddress(t *testing.T) {
+	c := testCandidates()[0]
+	tests := map[string]float64{
+		"群馬県高崎市八島町58-1":   1,
+		"高崎市八島町５８番地１":     1,
+		"群馬県高崎市":          1,
+		"群馬県高崎市八島町":       1,
+		"群馬県高崎市八島町1-1":    5.0 / 7,
+		"群馬県前橋市大手町1-1-1":  1.0 / 7,
+		"東京都千代田区丸の内1-1-1": 0,
+	}
+	for addr, expected := range tests {
+		q := parseQuery(Query{Name: "フィルイン", Address: addr})
+		if result, _ := addressSimilarity(q.addr, c); result != expected {
+			t.Errorf("%s: result:%v expected:%v", addr, result, expected)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"フィルイン", "フィルイン", 1},
+		{"フィルイン", "フィルイ", 0.8},
+		{"", "", 1},
+		{"abc", "xyz", 0},
+	}
+	for _, tt := range tests {
+		if result := similarity(tt.a, tt.b); result != tt.expected {
+			t.Errorf("%s, %s: result:%v expected:%v", tt.a, tt.b, result, tt.expected)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	results := Match(Query{Name: "株式会社フィルイン"}, testCandidates()[3:])
+	ja := results[0].Explain(corp.LocaleJa)
+	for _, s := range []string{"スコア: 0.85 (high)", "名称の類似度: 1.00 x 6.00 (フィルイン)", "法人格の一致: 1.00 x 1.00 (株式会社)", "登記記録の閉鎖: -0.15"} {
+		if !strings.Contains(ja, s) {
+			t.Errorf("%q is not contained.\n%s", s, ja)
+		}
+	}
+	if en := results[0].Factors[0].EnString(); en != "Name similarity: 1.00 x 6.00 (フィルイン)" {
+		t.Errorf("EnString is wrong. result:%s", en)
+	}
+}
@@ -0,0 +1,32 @@
+package corp
+
+import "github.com/fillin-inc/go-corp/normalize"
+
+// NormalizedName は商号又は名称(Name)を normalize.NameProfile で正規化して返します。
+func (c Corporation) NormalizedName() string {
+	return normalize.Name(c.Name)
+}
+
+// NormalizedFurigana はフリガナ(Furigana)を normalize.FuriganaProfile で正規化して返します。
+func (c Corporation) NormalizedFurigana() string {
+	return normalize.Furigana(c.Furigana)
+}
+
+// NormalizedEnName は英語表記(EnName)を normalize.EnNameProfile で正規化して返します。
+func (c Corporation) NormalizedEnName() string {
+	return normalize.EnName(c.EnName)
+}
+
+/*
+HasName は name が商号又は名称, フリガナ, 英語表記のいずれかと同じ名称か判定します。
+
+それぞれ NormalizedName, NormalizedFurigana, NormalizedEnName と同じ規則で正規化して比較します。
+*/
+func (c Corporation) HasName(name string) bool {
+	if name == "" {
+		return false
+	}
+	return (c.Name != "" && normalize.Name(name) == c.NormalizedName()) ||
+		(c.Furigana != "" && normalize.Furigana(name) == c.NormalizedFurigana()) ||
+		(c.EnName != "" && normalize.EnName(name) == c.NormalizedEnName())
+}
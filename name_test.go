@@ -0,0 +1,33 @@
+package corp
+
+import "testing"
+
+func TestNormalizedName(t *testing.T) {
+	c := Corporation{Name: "株式会社　フィルイン", Furigana: "ﾌｨﾙｲﾝ", EnName: "Fill-in  Inc."}
+	if result := c.NormalizedName(); result != "株式会社フィルイン" {
+		t.Errorf("Name is wrong. result:%s", result)
+	}
+	if result := c.NormalizedFurigana(); result != "フィルイン" {
+		t.Errorf("Furigana is wrong. result:%s", result)
+	}
+	if result := c.NormalizedEnName(); result != "fill-in inc." {
+		t.Errorf("EnName is wrong. result:%s", result)
+	}
+}
+
+func TestHasName(t *testing.T) {
+	c := Corporation{Name: "株式会社髙橋商店", Furigana: "タカハシショウテン", EnName: "Takahashi Shoten Co., Ltd."}
+	tests := map[string]bool{
+		"株式会社 高橋商店":                  true,
+		"ｶﾌﾞｼｷｶﾞｲｼｬ髙橋商店":             false,
+		"たかはししょうてん":                  true,
+		"TAKAHASHI SHOTEN CO., LTD.": true,
+		"高橋商店":                       false,
+		"":                           false,
+	}
+	for name, expected := range tests {
+		if result := c.HasName(name); result != expected {
+			t.Errorf("%s: result:%v expected:%v", name, result, expected)
+		}
+	}
+}
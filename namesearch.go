@@ -0,0 +1,154 @@
+package corp
+
+import (
+	"strings"
+
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// NameVariantType は検索に使用する名称の表記の種類です。
+type NameVariantType string
+
+const (
+	// 入力された名称
+	NameVariantOriginal NameVariantType = "original"
+	// 全角・半角を統一した名称
+	NameVariantWidth NameVariantType = "width"
+	// 異体字・旧字体を常用の字体にした名称
+	NameVariantKanji NameVariantType = "variant_kanji"
+	// 法人格の略称を正式な表記にした名称(例: (株)フィルイン → 株式会社フィルイン)
+	NameVariantLegalFormFull NameVariantType = "legal_form_full"
+	// 法人格の位置を前後入れ替えた名称(例: フィルイン株式会社 → 株式会社フィルイン)
+	NameVariantLegalFormSwapped NameVariantType = "legal_form_swapped"
+	// 法人格を除いた名称(例: フィルイン)
+	NameVariantLegalFormRemoved NameVariantType = "legal_form_removed"
+)
+
+// NameVariant は検索に使用する名称の表記です。
+type NameVariant struct {
+	Name string
+	Type NameVariantType
+}
+
+/*
+NameVariants は名称の表記の揺れを考慮した検索用の名称を返します。
+
+入力された名称, 全角・半角を統一した名称, 異体字・旧字体を常用の字体にした名称,
+法人格を正式な表記にした名称, 法人格の位置を入れ替えた名称, 法人格を除いた名称の順に返します。
+法人格に関する名称は全角・半角と字体を統一した名称から生成します。
+同じ名称になる表記は最初の 1 件のみ返します。
+
+	NameVariants("ﾌｨﾙｲﾝ(株)")
+	// [{ﾌｨﾙｲﾝ(株) original} {フィルイン(株) width} {フィルイン株式会社 legal_form_full}
+	//  {株式会社フィルイン legal_form_swapped} {フィルイン legal_form_removed}]
+*/
+func NameVariants(name string) []NameVariant {
+	var variants []NameVariant
+	seen := map[string]struct{}{}
+	add := func(n string, t NameVariantType) {
+		if n == "" {
+			return
+		}
+		if _, ok := seen[n]; ok {
+			return
+		}
+		seen[n] = struct{}{}
+		variants = append(variants, NameVariant{Name: n, Type: t})
+	}
+
+	add(strings.TrimSpace(name), NameVariantOriginal)
+	add(normalize.Profile{Width: true, Kana: true, Spaces: normalize.SpacesCollapse}.Normalize(name), NameVariantWidth)
+	normalized := normalize.Profile{Width: true, Kana: true, Variants: true, Spaces: normalize.SpacesCollapse}.Normalize(name)
+	add(normalized, NameVariantKanji)
+
+	p := ParseName(normalized)
+	if p.LegalForm == "" {
+		return variants
+	}
+	add(p.FullName(), NameVariantLegalFormFull)
+	swapped := p
+	switch p.Position {
+	case LegalFormPrefix:
+		swapped.Position = LegalFormSuffix
+	case LegalFormSuffix:
+		swapped.Position = LegalFormPrefix
+	}
+	add(swapped.FullName(), NameVariantLegalFormSwapped)
+	add(p.Core, NameVariantLegalFormRemoved)
+	return variants
+}
+
+// NameSearchHit は ExpandedNameSearch で見つかった 1 件の法人情報です。
+type NameSearchHit struct {
+	Corporation Corporation
+	// 法人情報が見つかった検索の名称(検索した順)
+	Variants []NameVariant
+}
+
+// ExpandedNameResponse は ExpandedNameSearch の結果です。
+type ExpandedNameResponse struct {
+	// 法人番号で重複を除いた法人情報(見つかった順)
+	Hits []NameSearchHit
+	// 検索した名称
+	Searched []NameVariant
+	// リクエスト数の上限により検索しなかった名称
+	Skipped []NameVariant
+}
+
+// Corporations は見つかった法人情報の一覧を返します。
+func (r ExpandedNameResponse) Corporations() []Corporation {
+	corps := make([]Corporation, 0, len(r.Hits))
+	for _, h := range r.Hits {
+		corps = append(corps, h.Corporation)
+	}
+	return corps
+}
+
+/*
+ExpandedNameSearch は名称の表記の揺れを考慮して法人情報を検索します。
+
+NameVariants で生成した名称ごとに NameSearch を実行し, 結果を法人番号で重複を除いてまとめます。
+各法人情報がどの名称の検索で見つかったかは NameSearchHit.Variants で確認できます。
+
+budget は Web-API へのリクエスト数の上限です。0 以下の場合はすべての名称を検索します。
+上限を超えた名称は検索せず ExpandedNameResponse.Skipped に設定します。
+
+リクエストがエラーになった場合はそれまでの結果とエラーを返します。
+address については NameSearch を参照してください。
+*/
+func ExpandedNameSearch(name string, address string, budget int) (ExpandedNameResponse, error) {
+	var res ExpandedNameResponse
+	index := map[uint64]int{}
+	for _, v := range NameVariants(name) {
+		if budget > 0 && len(res.Searched) >= budget {
+			res.Skipped = append(res.Skipped, v)
+			continue
+		}
+
+		res.Searched = append(res.Searched, v)
+		r, err := NameSearch(v.Name, address)
+		if err != nil {
+			return res, err
+		}
+		for _, c := range r.Corporations {
+			if i, ok := index[c.CorporateNumber]; ok {
+				if hit := &res.Hits[i]; !hasNameVariant(hit.Variants, v) {
+					hit.Variants = append(hit.Variants, v)
+				}
+				continue
+			}
+			index[c.CorporateNumber] = len(res.Hits)
+			res.Hits = append(res.Hits, NameSearchHit{Corporation: c, Variants: []NameVariant{v}})
+		}
+	}
+	return res, nil
+}
+
+func hasNameVariant(variants []NameVariant, v NameVariant) bool {
+	for _, x := range variants {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package corp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestNameVariants(t *testing.T) {
+	tests := map[string][]NameVariant{
+		"フィルイン株式会社": {
+			{"フィルイン株式会社", NameVariantOriginal},
+			{"株式会社フィルイン", NameVariantLegalFormSwapped},
+			{"フィルイン", NameVariantLegalFormRemoved},
+		},
+		"ﾌｨﾙｲﾝ(株)": {
+			{"ﾌｨﾙｲﾝ(株)", NameVariantOriginal},
+			{"フィルイン(株)", NameVariantWidth},
+			{"フィルイン株式会社", NameVariantLegalFormFull},
+			{"株式会社フィルイン", NameVariantLegalFormSwapped},
+			{"フィルイン", NameVariantLegalFormRemoved},
+		},
+		"株式会社髙橋商店": {
+			{"株式会社髙橋商店", NameVariantOriginal},
+			{"株式会社高橋商店", NameVariantKanji},
+			{"高橋商店株式会社", NameVariantLegalFormSwapped},
+			{"高橋商店", NameVariantLegalFormRemoved},
+		},
+		" フィルイン ": {
+			{"フィルイン", NameVariantOriginal},
+		},
+		"": nil,
+	}
+
+	for name, expected := range tests {
+		if result := NameVariants(name); !reflect.DeepEqual(result, expected) {
+			t.Errorf("variants are wrong. name:%q result:%v expected:%v", name, result, expected)
+		}
+	}
+}
+
+func TestExpandedNameSearch(t *testing.T) {
+	SetAppID("your-token")
+
+	t.Run("Basic Usage", func(t *testing.T) {
+		var names []string
+		ts := testNameServer(&names, map[string]string{
+			"株式会社フィルイン": "./testdata/response/name_search.xml",
+			"フィルイン":     "./testdata/response/name_search.xml",
+		})
+		defer ts.Close()
+		setTestEnvToRequest(ts)
+
+		res, err := ExpandedNameSearch("フィルイン株式会社", "10202", 0)
+		if err != nil {
+			t.Fatalf("error! %v", err)
+		}
+
+		expectedNames := []string{"フィルイン株式会社", "株式会社フィルイン", "フィルイン"}
+		if !reflect.DeepEqual(names, expectedNames) {
+			t.Errorf("searched names are wrong. result:%v expected:%v", names, expectedNames)
+		}
+		if len(res.Hits) != 1 {
+			t.Fatalf("hits count is wrong. result:%d expected:%d", len(res.Hits), 1)
+		}
+		if res.Hits[0].Corporation.CorporateNumber != testFillinCorpNum {
+			t.Errorf("corporate number is wrong. result:%d expected:%d", res.Hits[0].Corporation.CorporateNumber, testFillinCorpNum)
+		}
+		expected := []NameVariant{
+			{"株式会社フィルイン", NameVariantLegalFormSwapped},
+			{"フィルイン", NameVariantLegalFormRemoved},
+		}
+		if !reflect.DeepEqual(res.Hits[0].Variants, expected) {
+			t.Errorf("variants are wrong. result:%v expected:%v", res.Hits[0].Variants, expected)
+		}
+		if len(res.Skipped) != 0 {
+			t.Errorf("skipped variants exist. result:%v", res.Skipped)
+		}
+		if corps := res.Corporations(); len(corps) != 1 || corps[0].CorporateNumber != testFillinCorpNum {
+			t.Errorf("corporations are wrong. result:%v", corps)
+		}
+	})
+
+	t.Run("Budget", func(t *testing.T) {
+		var names []string
+		ts := testNameServer(&names, map[string]string{
+			"株式会社フィルイン": "./testdata/response/name_search.xml",
+		})
+		defer ts.Close()
+		setTestEnvToRequest(ts)
+
+		res, err := ExpandedNameSearch("フィルイン株式会社", "", 2)
+		if err != nil {
+			t.Fatalf("error! %v", err)
+		}
+		if len(names) != 2 {
+			t.Errorf("request count is wrong. result:%d expected:%d", len(names), 2)
+		}
+		expected := []NameVariant{{"フィルイン", NameVariantLegalFormRemoved}}
+		if !reflect.DeepEqual(res.Skipped, expected) {
+			t.Errorf("skipped variants are wrong. result:%v expected:%v", res.Skipped, expected)
+		}
+		if len(res.Hits) != 1 {
+			t.Errorf("hits count is wrong. result:%d expected:%d", len(res.Hits), 1)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ts := testErrorServer(http.StatusNotFound, "text/plain", "")
+		defer ts.Close()
+		setTestEnvToRequest(ts)
+
+		res, err := ExpandedNameSearch("フィルイン株式会社", "", 0)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("error type is wrong. result:%T", err)
+		}
+		if len(res.Searched) != 1 {
+			t.Errorf("searched count is wrong. result:%d expected:%d", len(res.Searched), 1)
+		}
+	})
+}
+
+// testNameServer は商号又は名称ごとに XML を返し, 検索された名称を names に記録します。
+func testNameServer(names *[]string, responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		*names = append(*names, name)
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		xmlPath, ok := responses[name]
+		if !ok {
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><corporations><count>0</count><divideNumber>1</divideNumber><divideSize>1</divideSize></corporations>`))
+			return
+		}
+		data, _ := os.ReadFile(xmlPath)
+		if _, err := w.Write(data); err != nil {
+			panic(err)
+		}
+	}))
+}
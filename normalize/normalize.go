@@ -0,0 +1,178 @@
+/*
+商号又は名称, フリガナ, 英語表記の表記ゆれを正規化するパッケージです。
+
+利用者が入力した名称と法人情報の名称は全角・半角, ひらがな・カタカナ, 異体字, 空白の有無などが異なることがあります。
+このパッケージは「同じ名称」の判定基準を Profile として定義し, ライブラリ内の検索や照合で共通に使用します。
+
+	normalize.Name("ｶﾌﾞｼｷｶﾞｲｼｬ　髙橋") == normalize.Name("カブシキガイシャ高橋") // true
+	normalize.NameProfile.Equal("株式会社 フィルイン", "株式会社ふぃるいん")      // true
+
+独自の基準が必要な場合は Profile の各項目を指定します。
+
+	p := normalize.Profile{Width: true, Kana: true, Spaces: normalize.SpacesRemove}
+	p.Normalize("ＡＢＣ　ｶﾝﾊﾟﾆｰ") // ABCカンパニー
+*/
+package normalize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SpaceMode は空白の扱いです。
+type SpaceMode uint8
+
+const (
+	// 空白を変更しない
+	SpacesKeep SpaceMode = iota
+	// 連続する空白を 1 つの半角空白にまとめ, 前後の空白を取り除く
+	SpacesCollapse
+	// すべての空白を取り除く
+	SpacesRemove
+)
+
+// Profile は正規化の規則です。ゼロ値は何も変換しません。
+type Profile struct {
+	// 全角の英数字・記号・空白を半角に変換する(例: ＡＢＣ１ → ABC1)
+	Width bool
+	// 半角カタカナを全角に変換する(例: ﾌｨﾙｲﾝ → フィルイン)
+	Kana bool
+	// ひらがなをカタカナに変換する(例: ふぃるいん → フィルイン)
+	Hiragana bool
+	// 異体字・旧字体を常用の字体に変換する(例: 髙 → 高, 﨑 → 崎)
+	Variants bool
+	// カナに続くハイフン・波線などを長音記号「ー」に統一する
+	LongVowel bool
+	// 長音記号を取り除く(例: コンピューター → コンピュタ)
+	DropLongVowel bool
+	// 英字の大文字を小文字に変換する
+	FoldCase bool
+	// 空白の扱い
+	Spaces SpaceMode
+}
+
+var (
+	// NameProfile は商号又は名称の正規化の規則です。
+	NameProfile = Profile{
+		Width:     true,
+		Kana:      true,
+		Hiragana:  true,
+		Variants:  true,
+		LongVowel: true,
+		FoldCase:  true,
+		Spaces:    SpacesRemove,
+	}
+	// FuriganaProfile はフリガナの正規化の規則です。
+	FuriganaProfile = Profile{
+		Width:     true,
+		Kana:      true,
+		Hiragana:  true,
+		LongVowel: true,
+		FoldCase:  true,
+		Spaces:    SpacesRemove,
+	}
+	// EnNameProfile は英語表記の正規化の規則です。
+	EnNameProfile = Profile{
+		Width:    true,
+		FoldCase: true,
+		Spaces:   SpacesCollapse,
+	}
+	// LooseProfile は長音記号の有無も区別しない, 照合用の緩い規則です。
+	LooseProfile = Profile{
+		Width:         true,
+		Kana:          true,
+		Hiragana:      true,
+		Variants:      true,
+		LongVowel:     true,
+		DropLongVowel: true,
+		FoldCase:      true,
+		Spaces:        SpacesRemove,
+	}
+)
+
+// Name は商号又は名称を NameProfile で正規化します。
+func Name(s string) string {
+	return NameProfile.Normalize(s)
+}
+
+// Furigana はフリガナを FuriganaProfile で正規化します。
+func Furigana(s string) string {
+	return FuriganaProfile.Normalize(s)
+}
+
+// EnName は英語表記を EnNameProfile で正規化します。
+func EnName(s string) string {
+	return EnNameProfile.Normalize(s)
+}
+
+/*
+Normalize は規則に従って文字列を正規化します。
+
+変換は全角・半角, 半角カタカナ, ひらがな, 異体字, 長音記号, 大文字・小文字, 空白の順に行います。
+*/
+func (p Profile) Normalize(s string) string {
+	if p.Width || p.Kana {
+		s = width(s, p.Width, p.Kana)
+	}
+	if p.Hiragana {
+		s = ToKatakana(s)
+	}
+	if p.Variants {
+		s = replaceVariants(s)
+	}
+	if p.LongVowel {
+		s = unifyLongVowel(s)
+	}
+	if p.DropLongVowel {
+		s = strings.ReplaceAll(s, "ー", "")
+	}
+	if p.FoldCase {
+		s = strings.ToLower(s)
+	}
+	switch p.Spaces {
+	case SpacesCollapse:
+		s = strings.Join(strings.Fields(s), " ")
+	case SpacesRemove:
+		s = strings.Join(strings.Fields(s), "")
+	}
+	return s
+}
+
+// Equal は 2 つの文字列が規則に従って正規化した結果で一致するか判定します。
+func (p Profile) Equal(a, b string) bool {
+	return p.Normalize(a) == p.Normalize(b)
+}
+
+// Width は全角の英数字・記号・空白を半角に, 半角カタカナを全角に変換します。
+func Width(s string) string {
+	return width(s, true, true)
+}
+
+// ToKatakana はひらがなをカタカナに変換します。
+func ToKatakana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ぁ' && r <= 'ゖ' || r == 'ゝ' || r == 'ゞ' {
+			return r + ('ァ' - 'ぁ')
+		}
+		return r
+	}, s)
+}
+
+// unifyLongVowel はカナに続くハイフン・波線などを長音記号に変換します。
+func unifyLongVowel(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i == 0 || !isKana(runes[i-1]) && runes[i-1] != 'ー' {
+			continue
+		}
+		switch r {
+		case '-', '‐', '‑', '‒', '–', '—', '―', '−', '─', '━', '~', '〜', '～', 'ｰ':
+			runes[i] = 'ー'
+		}
+	}
+	return string(runes)
+}
+
+func isKana(r rune) bool {
+	return unicode.In(r, unicode.Hiragana, unicode.Katakana) && r != 'ー' && r != '・'
+}
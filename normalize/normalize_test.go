@@ -0,0 +1,84 @@
+package normalize
+
+import "testing"
+
+func TestProfileNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  Profile
+		input    string
+		expected string
+	}{
+		{"zero", Profile{}, "ＡＢＣ　ｶﾌﾞ", "ＡＢＣ　ｶﾌﾞ"},
+		{"width", Profile{Width: true}, "ＡＢＣ１２３　（株）ｶﾌﾞ", "ABC123 (株)ｶﾌﾞ"},
+		{"kana", Profile{Kana: true}, "ｶﾌﾞｼｷｶﾞｲｼｬ ﾊﾟｰﾄﾅｰｽﾞ ＡＢＣ", "カブシキガイシャ パートナーズ ＡＢＣ"},
+		{"hiragana", Profile{Hiragana: true}, "ふぃるいん株式会社", "フィルイン株式会社"},
+		{"variants", Profile{Variants: true}, "髙﨑德塚", "高崎徳塚"},
+		{"long vowel", Profile{LongVowel: true}, "コンピュ-タ〜 A-1", "コンピューター A-1"},
+		{"drop long vowel", Profile{LongVowel: true, DropLongVowel: true}, "コンピュ-ター", "コンピュタ"},
+		{"fold case", Profile{FoldCase: true}, "Fill-in Inc.", "fill-in inc."},
+		{"collapse spaces", Profile{Spaces: SpacesCollapse}, "  Fill-in\t  Inc. ", "Fill-in Inc."},
+		{"remove spaces", Profile{Spaces: SpacesRemove}, " 株式会社　フィルイン ", "株式会社フィルイン"},
+		{"name", NameProfile, "ｶﾌﾞｼｷｶﾞｲｼｬ　髙橋ｺｰﾋｰ ＡＢＣ", "カブシキガイシャ高橋コーヒーabc"},
+		{"furigana", FuriganaProfile, "たかはし　こ-ひ-", "タカハシコーヒー"},
+		{"en name", EnNameProfile, "ＦＩＬＬ－ＩＮ　 Inc.", "fill-in inc."},
+		{"loose", LooseProfile, "コーヒー 髙橋", "コヒ高橋"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.profile.Normalize(tt.input); result != tt.expected {
+			t.Errorf("%s: result:%s expected:%s", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestProfileEqual(t *testing.T) {
+	if !NameProfile.Equal("株式会社 フィルイン", "株式会社ふぃるいん") {
+		t.Error("names are not equal.")
+	}
+	if NameProfile.Equal("株式会社フィルイン", "フィルイン株式会社") {
+		t.Error("names are equal.")
+	}
+	if !LooseProfile.Equal("コンピューター", "コンピュータ") {
+		t.Error("names are not equal.")
+	}
+}
+
+func TestShortcuts(t *testing.T) {
+	if result := Name("ｶﾌﾞｼｷｶﾞｲｼｬ　髙橋"); result != Name("カブシキガイシャ高橋") {
+		t.Errorf("Name is wrong. result:%s", result)
+	}
+	if result := Furigana("ﾌｨﾙｲﾝ"); result != "フィルイン" {
+		t.Errorf("Furigana is wrong. result:%s", result)
+	}
+	if result := EnName(" Fill-in  INC. "); result != "fill-in inc." {
+		t.Errorf("EnName is wrong. result:%s", result)
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := map[string]string{
+		"ﾌｨﾙｲﾝ":  "フィルイン",
+		"ｳﾞｨﾗ":   "ヴィラ",
+		"ﾎﾟﾝﾌﾟ":  "ポンプ",
+		"ｱﾞ":     "ア゛",
+		"１０Ｆ　ビル": "10F ビル",
+	}
+	for input, expected := range tests {
+		if result := Width(input); result != expected {
+			t.Errorf("%s: result:%s expected:%s", input, result, expected)
+		}
+	}
+}
+
+func TestToKatakana(t *testing.T) {
+	if result := ToKatakana("ふぃるいん・ゔぁ"); result != "フィルイン・ヴァ" {
+		t.Errorf("result is wrong. result:%s", result)
+	}
+}
+
+func TestVariant(t *testing.T) {
+	if Variant('髙') != '高' || Variant('高') != '高' {
+		t.Error("result is wrong.")
+	}
+}
@@ -0,0 +1,50 @@
+package normalize
+
+import "strings"
+
+/*
+異体字・旧字体と常用の字体の対応です。
+
+商号や人名で使われることが多く, 入力時に常用の字体で代用されやすい文字を対象とします。
+竜と龍, 斉と斎のように別の字として使い分けられる文字は含みません。
+*/
+var variants = map[rune]rune{
+	'髙': '高', '﨑': '崎', '嵜': '崎', '碕': '崎', '𠮷': '吉', '德': '徳',
+	'邊': '辺', '邉': '辺', '澤': '沢', '濱': '浜', '濵': '浜', '齋': '斎', '齊': '斉',
+	'櫻': '桜', '廣': '広', '國': '国', '關': '関', '黑': '黒', '藏': '蔵', '榮': '栄',
+	'眞': '真', '惠': '恵', '顯': '顕', '鐵': '鉄', '會': '会', '寶': '宝', '與': '与',
+	'萬': '万', '壽': '寿', '豐': '豊', '圓': '円', '縣': '県', '學': '学', '醫': '医',
+	'藝': '芸', '發': '発', '來': '来', '氣': '気', '傳': '伝', '實': '実', '舘': '館',
+	'嶋': '島', '嶌': '島', '冨': '富', '條': '条', '爲': '為', '聯': '連',
+	'禮': '礼', '靜': '静', '驛': '駅', '鄕': '郷', '亞': '亜', '惡': '悪',
+	'繪': '絵', '假': '仮', '勞': '労', '勵': '励', '勸': '勧', '單': '単', '囘': '回',
+	'團': '団', '增': '増', '處': '処', '戰': '戦', '擴': '拡', '數': '数', '斷': '断',
+	'晝': '昼', '樂': '楽', '歐': '欧', '歸': '帰', '燈': '灯', '營': '営',
+	'獨': '独', '產': '産', '當': '当', '盡': '尽', '硏': '研', '禪': '禅', '稅': '税',
+	'經': '経', '綠': '緑', '縱': '縦', '總': '総', '聲': '声', '臺': '台', '舊': '旧',
+	'藥': '薬', '號': '号', '蠶': '蚕', '衞': '衛', '裝': '装', '證': '証', '讀': '読',
+	'變': '変', '賣': '売', '轉': '転', '辯': '弁', '遞': '逓', '遲': '遅', '醱': '醗',
+	'錢': '銭', '鑛': '鉱', '險': '険', '隱': '隠', '雙': '双', '靈': '霊', '餘': '余',
+	'驗': '験', '體': '体', '髮': '髪', '鹽': '塩', '麥': '麦', '齒': '歯', '龜': '亀',
+	// CJK 互換漢字
+	'\uFA10': '塚', '\uFA12': '晴', '\uFA16': '猪', '\uFA17': '益', '\uFA18': '礼',
+	'\uFA19': '神', '\uFA1A': '祥', '\uFA1B': '福', '\uFA1C': '靖', '\uFA1D': '精',
+}
+
+// replaceVariants は異体字・旧字体を常用の字体に変換します。
+func replaceVariants(s string) string {
+	return strings.Map(func(r rune) rune {
+		if v, ok := variants[r]; ok {
+			return v
+		}
+		return r
+	}, s)
+}
+
+// Variant は異体字・旧字体に対応する常用の字体を返します。対応する字体がない場合は r をそのまま返します。
+func Variant(r rune) rune {
+	if v, ok := variants[r]; ok {
+		return v
+	}
+	return r
+}
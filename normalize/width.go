@@ -0,0 +1,72 @@
+package normalize
+
+// 半角カタカナと全角カタカナの対応
+var halfKana = map[rune]rune{
+	'ｦ': 'ヲ', 'ｧ': 'ァ', 'ｨ': 'ィ', 'ｩ': 'ゥ', 'ｪ': 'ェ', 'ｫ': 'ォ', 'ｬ': 'ャ', 'ｭ': 'ュ', 'ｮ': 'ョ', 'ｯ': 'ッ',
+	'ｰ': 'ー', 'ｱ': 'ア', 'ｲ': 'イ', 'ｳ': 'ウ', 'ｴ': 'エ', 'ｵ': 'オ', 'ｶ': 'カ', 'ｷ': 'キ', 'ｸ': 'ク', 'ｹ': 'ケ',
+	'ｺ': 'コ', 'ｻ': 'サ', 'ｼ': 'シ', 'ｽ': 'ス', 'ｾ': 'セ', 'ｿ': 'ソ', 'ﾀ': 'タ', 'ﾁ': 'チ', 'ﾂ': 'ツ', 'ﾃ': 'テ',
+	'ﾄ': 'ト', 'ﾅ': 'ナ', 'ﾆ': 'ニ', 'ﾇ': 'ヌ', 'ﾈ': 'ネ', 'ﾉ': 'ノ', 'ﾊ': 'ハ', 'ﾋ': 'ヒ', 'ﾌ': 'フ', 'ﾍ': 'ヘ',
+	'ﾎ': 'ホ', 'ﾏ': 'マ', 'ﾐ': 'ミ', 'ﾑ': 'ム', 'ﾒ': 'メ', 'ﾓ': 'モ', 'ﾔ': 'ヤ', 'ﾕ': 'ユ', 'ﾖ': 'ヨ', 'ﾗ': 'ラ',
+	'ﾘ': 'リ', 'ﾙ': 'ル', 'ﾚ': 'レ', 'ﾛ': 'ロ', 'ﾜ': 'ワ', 'ﾝ': 'ン', '｡': '。', '｢': '「', '｣': '」', '､': '、',
+	'･': '・',
+}
+
+// width は全角英数字・記号を半角に(ascii), 半角カタカナを全角に(kana)変換します。
+func width(s string, ascii, kana bool) string {
+	runes := []rune(s)
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case ascii && r >= '！' && r <= '～':
+			out = append(out, r-'！'+'!')
+		case ascii && r == '　':
+			out = append(out, ' ')
+		case kana && halfKana[r] != 0:
+			k := halfKana[r]
+			if i+1 < len(runes) {
+				switch runes[i+1] {
+				case 'ﾞ':
+					if v, ok := voiced(k); ok {
+						k = v
+						i++
+					}
+				case 'ﾟ':
+					if v, ok := semiVoiced(k); ok {
+						k = v
+						i++
+					}
+				}
+			}
+			out = append(out, k)
+		case kana && r == 'ﾞ':
+			out = append(out, '゛')
+		case kana && r == 'ﾟ':
+			out = append(out, '゜')
+		default:
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
+
+// voiced はカタカナの濁音を返します。
+func voiced(r rune) (rune, bool) {
+	switch {
+	case r == 'ウ':
+		return 'ヴ', true
+	case r >= 'カ' && r <= 'チ' && (r-'カ')%2 == 0,
+		r == 'ツ', r == 'テ', r == 'ト',
+		r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0:
+		return r + 1, true
+	}
+	return r, false
+}
+
+// semiVoiced はカタカナの半濁音を返します。
+func semiVoiced(r rune) (rune, bool) {
+	if r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0 {
+		return r + 2, true
+	}
+	return r, false
+}
@@ -0,0 +1,188 @@
+package corp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fillin-inc/go-corp/checkdigit"
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+/*
+CorporateNumber は 13 桁の法人番号です。ゼロ値は法人番号がないことを表します。
+
+テキスト, JSON, XML では 13 桁の文字列(例: "5070001032626")に変換し,
+データベースには 13 桁の文字列として保存します。
+*/
+type CorporateNumber uint64
+
+/*
+ParseCorporateNumber は文字列を法人番号に変換します。
+
+全角数字と, 数字の間の空白・ハイフンに対応します。
+
+	ParseCorporateNumber("5070001032626")
+	ParseCorporateNumber("５０７０００１０３２６２６")
+	ParseCorporateNumber("5-0700-0103-2626")
+
+数字以外の文字を含む場合は *checkdigit.DigitError を, 13 桁でない場合は *checkdigit.LengthError を,
+チェックデジットが一致しない場合は *checkdigit.MismatchError を返します。
+*/
+func ParseCorporateNumber(s string) (CorporateNumber, error) {
+	n, err := parseNumber(s, normalize.Width(s))
+	return CorporateNumber(n), err
+}
+
+// parseNumber は半角にした文字列 digits から空白・ハイフンを取り除いて 13 桁の番号として読み込み, チェックデジットを検証します。
+// input はエラーに含める入力された文字列です。
+func parseNumber(input, digits string) (uint64, error) {
+	digits = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || isHyphen(r) {
+			return -1
+		}
+		return r
+	}, digits)
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, &checkdigit.DigitError{Input: input, Char: r}
+		}
+	}
+	if len(digits) != 13 {
+		return 0, &checkdigit.LengthError{Length: len(digits)}
+	}
+
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkdigit.Validate(n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// isHyphen はハイフン・ダッシュ・長音記号など, 番号の区切りとして入力される文字か判定します。
+func isHyphen(r rune) bool {
+	return strings.ContainsRune("-‐‑‒–—―−ーｰ", r)
+}
+
+// Uint64 は法人番号を数値で返します。
+func (n CorporateNumber) Uint64() uint64 {
+	return uint64(n)
+}
+
+// IsZero は法人番号がゼロ値か判定します。
+func (n CorporateNumber) IsZero() bool {
+	return n == 0
+}
+
+// Validate は法人番号の桁数とチェックデジットを検証します。エラーは checkdigit.Validate と同じです。
+func (n CorporateNumber) Validate() error {
+	return checkdigit.Validate(uint64(n))
+}
+
+// CheckDigit は 1 桁目のチェックデジットを返します。
+func (n CorporateNumber) CheckDigit() int {
+	return int(uint64(n) / 1000000000000)
+}
+
+// String は 13 桁の法人番号(例: 5070001032626)を返します。ゼロ値の場合は空文字を返します。
+func (n CorporateNumber) String() string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%013d", uint64(n))
+}
+
+// Hyphenated は 1-4-4-4 桁をハイフンで区切った法人番号(例: 5-0700-0103-2626)を返します。ゼロ値の場合は空文字を返します。
+func (n CorporateNumber) Hyphenated() string {
+	s := n.String()
+	if s == "" {
+		return ""
+	}
+	return s[:1] + "-" + s[1:5] + "-" + s[5:9] + "-" + s[9:]
+}
+
+// MarshalText は法人番号を String の形式に変換します。
+func (n CorporateNumber) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText は ParseCorporateNumber で法人番号を読み込みます。空文字はゼロ値とします。
+func (n *CorporateNumber) UnmarshalText(b []byte) error {
+	if strings.TrimSpace(string(b)) == "" {
+		*n = 0
+		return nil
+	}
+	v, err := ParseCorporateNumber(string(b))
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
+
+// MarshalJSON は法人番号を 13 桁の文字列に変換します。ゼロ値は null とします。
+func (n CorporateNumber) MarshalJSON() ([]byte, error) {
+	if n == 0 {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON は文字列または数値の法人番号を読み込みます。null と空文字はゼロ値とします。
+func (n *CorporateNumber) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*n = 0
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+	return n.UnmarshalText([]byte(s))
+}
+
+// Scan はデータベースの文字列または数値の値を法人番号として読み込みます。NULL はゼロ値とします。
+func (n *CorporateNumber) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*n = 0
+		return nil
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("cannot scan negative value into corporate number: %d", v)
+		}
+		return n.UnmarshalText([]byte(strconv.FormatInt(v, 10)))
+	case string:
+		return n.UnmarshalText([]byte(v))
+	case []byte:
+		return n.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into corporate number", src)
+}
+
+// Value は法人番号を 13 桁の文字列としてデータベースに保存します。ゼロ値は NULL とします。
+func (n CorporateNumber) Value() (driver.Value, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// Number は法人番号(CorporateNumber)を CorporateNumber で返します。
+func (c Corporation) Number() CorporateNumber {
+	return CorporateNumber(c.CorporateNumber)
+}
+
+// SuccessorNumber は承継先法人番号(SuccessorCorporateNumber)を CorporateNumber で返します。
+func (c Corporation) SuccessorNumber() CorporateNumber {
+	return CorporateNumber(c.SuccessorCorporateNumber)
+}
@@ -0,0 +1,165 @@
+package corp
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/fillin-inc/go-corp/checkdigit"
+)
+
+func TestParseCorporateNumber(t *testing.T) {
+	tests := []string{
+		"5070001032626",
+		"５０７０００１０３２６２６",
+		"5-0700-0103-2626",
+		"５－０７００－０１０３－２６２６",
+		" 5070 0010 32626 ",
+		"5‐0700‐0103‐2626",
+	}
+	for _, test := range tests {
+		n, err := ParseCorporateNumber(test)
+		if err != nil {
+			t.Errorf("%q: error! %v", test, err)
+		}
+		if n != CorporateNumber(testFillinCorpNum) {
+			t.Errorf("%q: result:%d expected:%d", test, n, testFillinCorpNum)
+		}
+	}
+}
+
+func TestParseCorporateNumberError(t *testing.T) {
+	var digitErr *checkdigit.DigitError
+	if _, err := ParseCorporateNumber("507000103262a"); !errors.As(err, &digitErr) || digitErr.Char != 'a' {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+
+	var lengthErr *checkdigit.LengthError
+	if _, err := ParseCorporateNumber("507000103262"); !errors.As(err, &lengthErr) || lengthErr.Length != 12 {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+	if _, err := ParseCorporateNumber(""); !errors.As(err, &lengthErr) || lengthErr.Length != 0 {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+
+	var mismatchErr *checkdigit.MismatchError
+	if _, err := ParseCorporateNumber("4070001032626"); !errors.As(err, &mismatchErr) || mismatchErr.Expected != 5 {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
+func TestCorporateNumberFormat(t *testing.T) {
+	n := CorporateNumber(testFillinCorpNum)
+	if result := n.String(); result != "5070001032626" {
+		t.Errorf("String is wrong. result:%s", result)
+	}
+	if result := n.Hyphenated(); result != "5-0700-0103-2626" {
+		t.Errorf("Hyphenated is wrong. result:%s", result)
+	}
+	if result := n.CheckDigit(); result != 5 {
+		t.Errorf("CheckDigit is wrong. result:%d", result)
+	}
+	if n.Validate() != nil || n.IsZero() {
+		t.Errorf("number is invalid. %d", n)
+	}
+
+	var zero CorporateNumber
+	if zero.String() != "" || zero.Hyphenated() != "" || !zero.IsZero() {
+		t.Errorf("zero value is wrong. %q %q", zero.String(), zero.Hyphenated())
+	}
+}
+
+func TestCorporateNumberJSON(t *testing.T) {
+	type record struct {
+		Number    CorporateNumber `json:"number"`
+		Successor CorporateNumber `json:"successor"`
+	}
+
+	b, err := json.Marshal(record{Number: CorporateNumber(testFillinCorpNum)})
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if string(b) != `{"number":"5070001032626","successor":null}` {
+		t.Errorf("JSON is wrong. result:%s", b)
+	}
+
+	tests := []string{
+		`{"number":"5070001032626","successor":null}`,
+		`{"number":5070001032626,"successor":""}`,
+		`{"number":"5-0700-0103-2626"}`,
+	}
+	for _, test := range tests {
+		var r record
+		if err := json.Unmarshal([]byte(test), &r); err != nil {
+			t.Errorf("%s: error! %v", test, err)
+		}
+		if r.Number != CorporateNumber(testFillinCorpNum) || r.Successor != 0 {
+			t.Errorf("%s: result:%+v", test, r)
+		}
+	}
+
+	var r record
+	var mismatchErr *checkdigit.MismatchError
+	if err := json.Unmarshal([]byte(`{"number":"4070001032626"}`), &r); !errors.As(err, &mismatchErr) {
+		t.Errorf("error is wrong. result:%v", err)
+	}
+}
+
+func TestCorporateNumberXML(t *testing.T) {
+	type record struct {
+		Number    CorporateNumber `xml:"corporateNumber"`
+		Successor CorporateNumber `xml:"successorCorporateNumber"`
+	}
+
+	b, err := xml.Marshal(record{Number: CorporateNumber(testFillinCorpNum)})
+	if err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if string(b) != "<record><corporateNumber>5070001032626</corporateNumber><successorCorporateNumber></successorCorporateNumber></record>" {
+		t.Errorf("XML is wrong. result:%s", b)
+	}
+
+	var r record
+	if err := xml.Unmarshal([]byte("<record><corporateNumber>5070001032626</corporateNumber><successorCorporateNumber /></record>"), &r); err != nil {
+		t.Fatalf("error! %v", err)
+	}
+	if r.Number != CorporateNumber(testFillinCorpNum) || r.Successor != 0 {
+		t.Errorf("result:%+v", r)
+	}
+}
+
+func TestCorporateNumberSQL(t *testing.T) {
+	n := CorporateNumber(testFillinCorpNum)
+	if v, err := n.Value(); err != nil || v != "5070001032626" {
+		t.Errorf("Value is wrong. result:%v %v", v, err)
+	}
+	if v, err := CorporateNumber(0).Value(); err != nil || v != nil {
+		t.Errorf("Value is wrong. result:%v %v", v, err)
+	}
+
+	for _, src := range []interface{}{int64(5070001032626), "5070001032626", []byte("5070001032626")} {
+		var result CorporateNumber
+		if err := result.Scan(src); err != nil || result != n {
+			t.Errorf("%T: Scan is wrong. result:%d %v", src, result, err)
+		}
+	}
+
+	result := n
+	if err := result.Scan(nil); err != nil || result != 0 {
+		t.Errorf("Scan is wrong. result:%d %v", result, err)
+	}
+	if err := result.Scan(int64(-1)); err == nil {
+		t.Error("No error occurred.")
+	}
+	if err := result.Scan(1.5); err == nil {
+		t.Error("No error occurred.")
+	}
+}
+
+func TestCorporationNumber(t *testing.T) {
+	c := Corporation{CorporateNumber: testFillinCorpNum, SuccessorCorporateNumber: testGunmaCorpNum}
+	if c.Number() != CorporateNumber(testFillinCorpNum) || c.SuccessorNumber() != CorporateNumber(testGunmaCorpNum) {
+		t.Errorf("result:%d %d", c.Number(), c.SuccessorNumber())
+	}
+}
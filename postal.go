@@ -0,0 +1,117 @@
+package corp
+
+import (
+	"strings"
+
+	"github.com/fillin-inc/go-corp/address"
+)
+
+// 英語表記の所在地の国名
+const countryName = "JAPAN"
+
+/*
+PostalAddress は郵便番号と所在地を 1 行にまとめた文字列を返します。
+
+	〒370-0849 群馬県高崎市八島町58番地1 ウエストワンビル10F1011号
+
+国内所在地がなく国外所在地(AddressOutside)がある場合は国外所在地を返します。
+*/
+func (c Corporation) PostalAddress() string {
+	if !c.hasDomesticAddress() {
+		return c.AddressOutside
+	}
+	return c.Address().OneLine()
+}
+
+/*
+PostalAddressLines は宛名の印字用に郵便番号, 所在地, 建物名等, 商号又は名称を行ごとに返します。
+
+	〒370-0849
+	群馬県高崎市八島町58番地1
+	ウエストワンビル10F1011号
+	株式会社フィルイン
+
+国内所在地がなく国外所在地(AddressOutside)がある場合は国外所在地と商号又は名称を返します。
+*/
+func (c Corporation) PostalAddressLines() []string {
+	var lines []string
+	if c.hasDomesticAddress() {
+		lines = c.Address().Lines()
+	} else if c.AddressOutside != "" {
+		lines = append(lines, c.AddressOutside)
+	}
+	if c.Name != "" {
+		lines = append(lines, c.Name)
+	}
+	return lines
+}
+
+/*
+EnPostalAddress は英語表記の所在地を英語の順序で 1 行にまとめた文字列を返します。
+
+	58-1 Yashimacho, Takasaki shi, Gunma 370-0849, JAPAN
+
+英語表記の所在地がない場合は日本語の所在地, 郵便番号, 国名の順に返します。
+国内所在地がなく国外所在地がある場合は英語表記の国外所在地(EnAddressOutside)を,
+それもない場合は国外所在地(AddressOutside)を返します。
+*/
+func (c Corporation) EnPostalAddress() string {
+	return strings.Join(c.enAddressLines(), ", ")
+}
+
+/*
+EnPostalAddressLines は宛名の印字用に英語表記の商号又は名称と所在地を英語の順序で行ごとに返します。
+
+	Fill-in Inc.
+	58-1 Yashimacho, Takasaki shi
+	Gunma 370-0849
+	JAPAN
+
+英語表記の商号又は名称がない場合は商号又は名称を用います。
+所在地の補完は EnPostalAddress と同じです。
+*/
+func (c Corporation) EnPostalAddressLines() []string {
+	var lines []string
+	if name := c.EnName; name != "" {
+		lines = append(lines, name)
+	} else if c.Name != "" {
+		lines = append(lines, c.Name)
+	}
+	return append(lines, c.enAddressLines()...)
+}
+
+func (c Corporation) enAddressLines() []string {
+	if !c.hasDomesticAddress() {
+		if c.EnAddressOutside != "" {
+			return []string{c.EnAddressOutside}
+		}
+		if c.AddressOutside != "" {
+			return []string{c.AddressOutside}
+		}
+		return nil
+	}
+
+	postCode := strings.TrimPrefix(address.FormatPostCode(c.PostCode), "〒")
+	var lines []string
+	if c.EnPrefectureName == "" && c.EnCityName == "" {
+		a := c.Address()
+		a.PostCode = ""
+		lines = a.Lines()
+		if postCode != "" {
+			lines = append(lines, postCode)
+		}
+		return append(lines, countryName)
+	}
+
+	if c.EnCityName != "" {
+		lines = append(lines, c.EnCityName)
+	}
+	if region := strings.TrimSpace(c.EnPrefectureName + " " + postCode); region != "" {
+		lines = append(lines, region)
+	}
+	return append(lines, countryName)
+}
+
+func (c Corporation) hasDomesticAddress() bool {
+	return c.PrefectureName != "" || c.CityName != "" || c.StreetNumber != ""
+}
@@ -0,0 +1,69 @@
+package corp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPostalAddress(t *testing.T) {
+	c := Corporation{
+		Name:             "株式会社フィルイン",
+		PrefectureName:   "群馬県",
+		CityName:         "高崎市",
+		StreetNumber:     "八島町５８番地１ウエストワンビル１０Ｆ１０１１号",
+		PostCode:         "3700849",
+		EnName:           "Fill-in Inc.",
+		EnPrefectureName: "Gunma",
+		EnCityName:       "58-1 Yashimacho, Takasaki shi",
+	}
+
+	if result := c.PostalAddress(); result != "〒370-0849 群馬県高崎市八島町58番地1 ウエストワンビル10F1011号" {
+		t.Errorf("PostalAddress is wrong. result:%s", result)
+	}
+
+	expected := []string{"〒370-0849", "群馬県高崎市八島町58番地1", "ウエストワンビル10F1011号", "株式会社フィルイン"}
+	if result := c.PostalAddressLines(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("PostalAddressLines is wrong. result:%v expected:%v", result, expected)
+	}
+
+	if result := c.EnPostalAddress(); result != "58-1 Yashimacho, Takasaki shi, Gunma 370-0849, JAPAN" {
+		t.Errorf("EnPostalAddress is wrong. result:%s", result)
+	}
+
+	expected = []string{"Fill-in Inc.", "58-1 Yashimacho, Takasaki shi", "Gunma 370-0849", "JAPAN"}
+	if result := c.EnPostalAddressLines(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("EnPostalAddressLines is wrong. result:%v expected:%v", result, expected)
+	}
+}
+
+func TestEnPostalAddressWithoutEnglish(t *testing.T) {
+	c := Corporation{Name: "群馬県", PrefectureName: "群馬県", CityName: "前橋市", StreetNumber: "大手町１丁目１番１号", PostCode: "3710026"}
+
+	if result := c.EnPostalAddress(); result != "群馬県前橋市大手町1丁目1番1号, 371-0026, JAPAN" {
+		t.Errorf("EnPostalAddress is wrong. result:%s", result)
+	}
+
+	expected := []string{"群馬県", "群馬県前橋市大手町1丁目1番1号", "371-0026", "JAPAN"}
+	if result := c.EnPostalAddressLines(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("EnPostalAddressLines is wrong. result:%v expected:%v", result, expected)
+	}
+}
+
+func TestPostalAddressOutside(t *testing.T) {
+	c := Corporation{Name: "エービーシー", AddressOutside: "アメリカ合衆国ニューヨーク州", EnAddressOutside: "New York, U.S.A."}
+
+	if result := c.PostalAddress(); result != "アメリカ合衆国ニューヨーク州" {
+		t.Errorf("PostalAddress is wrong. result:%s", result)
+	}
+	if result := c.PostalAddressLines(); !reflect.DeepEqual(result, []string{"アメリカ合衆国ニューヨーク州", "エービーシー"}) {
+		t.Errorf("PostalAddressLines is wrong. result:%v", result)
+	}
+	if result := c.EnPostalAddress(); result != "New York, U.S.A." {
+		t.Errorf("EnPostalAddress is wrong. result:%s", result)
+	}
+
+	c.EnAddressOutside = ""
+	if result := c.EnPostalAddress(); result != "アメリカ合衆国ニューヨーク州" {
+		t.Errorf("EnPostalAddress is wrong. result:%s", result)
+	}
+}
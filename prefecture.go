@@ -0,0 +1,107 @@
+package corp
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fillin-inc/go-corp/address"
+)
+
+// PrefectureMismatchError は都道府県コードと都道府県名が一致しない場合のエラーです。
+type PrefectureMismatchError struct {
+	// 法人番号
+	CorporateNumber uint64
+	// 都道府県コード
+	PrefectureCode uint8
+	// 国内所在地(都道府県)
+	PrefectureName string
+	// 都道府県コードに対応する都道府県名
+	// 都道府県コードが不正な場合は空文字
+	Expected string
+}
+
+func (e *PrefectureMismatchError) Error() string {
+	if e.Expected == "" {
+		return fmt.Sprintf("%013d: unknown prefecture code %02d", e.CorporateNumber, e.PrefectureCode)
+	}
+	return fmt.Sprintf("%013d: prefecture name %q does not match code %02d (%s)", e.CorporateNumber, e.PrefectureName, e.PrefectureCode, e.Expected)
+}
+
+// Prefecture は都道府県コード(PrefectureCode)に対応する都道府県を返します。
+func (c Corporation) Prefecture() (address.Prefecture, bool) {
+	return address.PrefectureByCode(c.PrefectureCode)
+}
+
+// Municipality は都道府県コード(PrefectureCode)と市区町村コード(CityCode)に対応する市区町村を返します。
+func (c Corporation) Municipality() (address.Municipality, bool) {
+	return address.MunicipalityByCode(c.PrefectureCode, c.CityCode)
+}
+
+/*
+InMunicipality は所在地が団体コード code の市区町村にあるか判定します。
+
+法人情報と code の団体コードは合併等を反映した現在の団体コードで比較するため,
+合併前の団体コードでも判定できます。政令指定都市の団体コードはその区の法人情報にも一致します。
+*/
+func (c Corporation) InMunicipality(code string) bool {
+	if c.PrefectureCode == 0 {
+		return false
+	}
+	codes := address.ResolveMunicipalityCode(code, time.Time{})
+	for _, current := range address.ResolveMunicipalityCode(fmt.Sprintf("%02d%03d", c.PrefectureCode, c.CityCode), time.Time{}) {
+		parent := ""
+		if m, ok := address.MunicipalityByLocalGovCode(current); ok {
+			if p, ok := m.Parent(); ok {
+				parent = p.Code()
+			}
+		}
+		for _, code := range codes {
+			if code == current || code == parent {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+ValidatePrefecture は都道府県コード(PrefectureCode)と国内所在地(都道府県)(PrefectureName)が一致するか検証します。
+
+一致しない場合は *PrefectureMismatchError を返します。
+国外所在地の法人など, 都道府県コードと都道府県名がどちらも未設定の場合は検証しません。
+*/
+func (c Corporation) ValidatePrefecture() error {
+	if c.PrefectureCode == 0 && c.PrefectureName == "" {
+		return nil
+	}
+
+	err := &PrefectureMismatchError{
+		CorporateNumber: c.CorporateNumber,
+		PrefectureCode:  c.PrefectureCode,
+		PrefectureName:  c.PrefectureName,
+	}
+	pref, ok := c.Prefecture()
+	if !ok {
+		return err
+	}
+	if address.Normalize(c.PrefectureName) != pref.Name {
+		err.Expected = pref.Name
+		return err
+	}
+	return nil
+}
+
+// ValidatePrefectures はレスポンスに含まれる法人情報の ValidatePrefecture の結果をまとめて返します。
+func (r Response) ValidatePrefectures() error {
+	var errs []error
+	for _, c := range r.Corporations {
+		if !c.Available() {
+			continue
+		}
+		if err := c.ValidatePrefecture(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
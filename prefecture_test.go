@@ -0,0 +1,108 @@
+package corp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidatePrefecture(t *testing.T) {
+	tests := map[string]struct {
+		corp     Corporation
+		valid    bool
+		expected string
+	}{
+		"match":        {Corporation{PrefectureCode: 10, PrefectureName: "群馬県"}, true, ""},
+		"mismatch":     {Corporation{PrefectureCode: 10, PrefectureName: "栃木県"}, false, "群馬県"},
+		"unknown code": {Corporation{PrefectureCode: 48, PrefectureName: "群馬県"}, false, ""},
+		"no code":      {Corporation{PrefectureName: "群馬県"}, false, ""},
+		"overseas":     {Corporation{AddressOutside: "アメリカ合衆国"}, true, ""},
+	}
+
+	for name, tt := range tests {
+		err := tt.corp.ValidatePrefecture()
+		if tt.valid {
+			if err != nil {
+				t.Errorf("%s: error occurred. %v", name, err)
+			}
+			continue
+		}
+
+		var mismatch *PrefectureMismatchError
+		if !errors.As(err, &mismatch) {
+			t.Errorf("%s: error is wrong. result:%v", name, err)
+			continue
+		}
+		if mismatch.Expected != tt.expected {
+			t.Errorf("%s: expected name is wrong. result:%s expected:%s", name, mismatch.Expected, tt.expected)
+		}
+	}
+}
+
+func TestValidatePrefectures(t *testing.T) {
+	res := testResponse(t, "./testdata/response/by_numbers.xml")
+	if err := res.ValidatePrefectures(); err != nil {
+		t.Errorf("error occurred. %v", err)
+	}
+
+	res.Corporations[1].PrefectureName = "栃木県"
+	err := res.ValidatePrefectures()
+	if err == nil {
+		t.Fatal("No error occurred.")
+	}
+	if err.Error() != `7000020100005: prefecture name "栃木県" does not match code 10 (群馬県)` {
+		t.Errorf("error message is wrong. result:%s", err)
+	}
+
+	if pref, ok := res.Corporations[0].Prefecture(); !ok || pref.Name != "群馬県" {
+		t.Errorf("Prefecture is wrong. result:%+v", pref)
+	}
+}
+
+func TestMunicipality(t *testing.T) {
+	res := testResponse(t, "./testdata/response/by_numbers.xml")
+	m, ok := res.Corporations[0].Municipality()
+	if !ok || m.Name != "高崎市" || m.LocalGovCode() != "102024" {
+		t.Errorf("Municipality is wrong. result:%+v", m)
+	}
+
+	if _, ok := (Corporation{PrefectureCode: 13, CityCode: 999}).Municipality(); ok {
+		t.Error("unknown city code is found.")
+	}
+}
+
+func TestInMunicipality(t *testing.T) {
+	tests := []struct {
+		name     string
+		corp     Corporation
+		code     string
+		expected bool
+	}{
+		{"same code", Corporation{PrefectureCode: 10, CityCode: 202}, "10202", true},
+		{"local government code", Corporation{PrefectureCode: 10, CityCode: 202}, "102024", true},
+		{"other city", Corporation{PrefectureCode: 10, CityCode: 202}, "10201", false},
+		{"former city code", Corporation{PrefectureCode: 43, CityCode: 201}, "43100", true},
+		{"former city code of ward", Corporation{PrefectureCode: 43, CityCode: 101}, "43201", true},
+		{"designated city", Corporation{PrefectureCode: 14, CityCode: 104}, "14100", true},
+		{"other ward", Corporation{PrefectureCode: 14, CityCode: 104}, "14103", false},
+		{"reorganized ward", Corporation{PrefectureCode: 22, CityCode: 131}, "22138", true},
+		{"status changed", Corporation{PrefectureCode: 40, CityCode: 231}, "40305", true},
+		{"invalid code", Corporation{PrefectureCode: 10, CityCode: 202}, "1020", false},
+		{"no address", Corporation{}, "00000", false},
+	}
+
+	for _, tt := range tests {
+		if result := tt.corp.InMunicipality(tt.code); result != tt.expected {
+			t.Errorf("%s: result:%v expected:%v", tt.name, result, tt.expected)
+		}
+	}
+
+	res := Response{Corporations: []Corporation{
+		{SequenceNumber: 1, PrefectureCode: 22, CityCode: 202},
+		{SequenceNumber: 2, PrefectureCode: 22, CityCode: 138},
+		{SequenceNumber: 3, PrefectureCode: 22, CityCode: 100},
+	}}
+	filtered := res.Filter(InMunicipality("22202"))
+	if len(filtered.Corporations) != 2 || filtered.Corporations[1].SequenceNumber != 2 {
+		t.Errorf("filter result is wrong. %+v", filtered.Corporations)
+	}
+}
@@ -1,31 +1,19 @@
 package request
 
 import (
-	"regexp"
+	"strconv"
 	"time"
 
+	"github.com/fillin-inc/go-corp/address"
 	"github.com/fillin-inc/go-corp/checkdigit"
 	"github.com/go-playground/validator"
 )
 
 var (
-	// 都道府県コード
-	prefCodes = []string{
-		"01", "02", "03", "04", "05", "06", "07", "08", "09", "10",
-		"11", "12", "13", "14", "15", "16", "17", "18", "19", "20",
-		"21", "22", "23", "24", "25", "26", "27", "28", "29", "30",
-		"31", "32", "33", "34", "35", "36", "37", "38", "39", "40",
-		"41", "42", "43", "44", "45", "46", "47",
-		"99",
-	}
-
 	// 法人種別コード
 	kindCodes = []string{
 		"01", "02", "03", "04",
 	}
-
-	// 市区町村コード正規表現
-	rCityCode = regexp.MustCompile("^[0-9]{3}$")
 )
 
 func dateValidation(fl validator.FieldLevel) bool {
@@ -77,18 +65,16 @@ func addressValidation(fl validator.FieldLevel) bool {
 	}
 
 	if len(v) == 2 {
-		return containCodes(v, prefCodes)
+		return isPrefectureCode(v)
 	}
 
 	if len(v) == 5 {
-		prefCode := v[:2]
-		cityCode := v[2:]
-
-		if !containCodes(prefCode, prefCodes) {
-			return false
+		// 全国地方公共団体コードの一覧に存在する市区町村と,
+		// 合併等で廃止され現在の市区町村に変換できる団体コードは有効
+		if _, ok := currentMunicipalityCode(v); ok {
+			return true
 		}
-		// 市区町村コードは数が多いため正規表現チェックのみで判定
-		return rCityCode.MatchString(cityCode)
+		return maybeFormerMunicipalityCode(v)
 	}
 
 	return false
@@ -121,6 +107,63 @@ func checkdigitsValidation(fl validator.FieldLevel) bool {
 	return true
 }
 
+// isPrefectureCode は 2 桁の都道府県コードまたは国外(99)か判定します。
+func isPrefectureCode(v string) bool {
+	code, err := strconv.ParseUint(v, 10, 8)
+	if err != nil {
+		return false
+	}
+	return code == address.OverseasCode || address.IsPrefectureCode(uint8(code))
+}
+
+/*
+currentMunicipalityCode は合併等で廃止された団体コードを現在の団体コードに変換します。
+
+変更のない団体コードはそのまま返します。
+一覧に存在しない場合や区域が複数に分かれ 1 つの団体コードに変換できない場合は false を返します。
+*/
+func currentMunicipalityCode(v string) (string, bool) {
+	codes := address.ResolveMunicipalityCode(v, time.Time{})
+	if len(codes) != 1 {
+		return "", false
+	}
+	if _, ok := address.MunicipalityByLocalGovCode(codes[0]); !ok {
+		return "", false
+	}
+	return codes[0], true
+}
+
+/*
+maybeFormerMunicipalityCode は変更の履歴を収録していない都道府県の, 廃止された可能性のある団体コードか判定します。
+
+address.MunicipalityChanges は一部の都道府県の合併のみを収録しているため,
+収録していない都道府県では一覧にない団体コードも形式のみで判定します。
+*/
+func maybeFormerMunicipalityCode(v string) bool {
+	code, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return false
+	}
+	prefCode := uint8(code / 1000)
+	if !address.IsPrefectureCode(prefCode) || address.HasMunicipalityHistory(prefCode) {
+		return false
+	}
+	// 変更を収録している団体コード(区域が複数に分かれたものなど)は変換できないため無効
+	codes := address.ResolveMunicipalityCode(v, time.Time{})
+	return len(codes) == 1 && codes[0] == v
+}
+
+// resolveAddress は所在地が廃止された団体コードの場合に現在の団体コードに変換します。
+func resolveAddress(v string) string {
+	if len(v) != 5 {
+		return v
+	}
+	if code, ok := currentMunicipalityCode(v); ok {
+		return code
+	}
+	return v
+}
+
 func containCodes(target string, codes []string) bool {
 	for _, c := range codes {
 		if target == c {
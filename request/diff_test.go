@@ -86,6 +86,26 @@ func TestDiffValidate(t *testing.T) {
 			Divide:       1,
 			ResponseType: RESPONSE_TYPE,
 		},
+		// Address has former CityCode
+		{
+			ID:           "your-token",
+			From:         "2021-07-19",
+			To:           "2021-07-19",
+			Address:      "10361",
+			Kind:         []string{},
+			Divide:       1,
+			ResponseType: RESPONSE_TYPE,
+		},
+		// Address has former CityCode not in municipality history
+		{
+			ID:           "your-token",
+			From:         "2021-07-19",
+			To:           "2021-07-19",
+			Address:      "11201",
+			Kind:         []string{},
+			Divide:       1,
+			ResponseType: RESPONSE_TYPE,
+		},
 		// Kind has 1 KindCode
 		{
 			ID:           "your-token",
@@ -243,6 +263,19 @@ func TestDiffValidateError(t *testing.T) {
 			},
 			"Key: 'Diff.Address' Error:Field validation for 'Address' failed on the 'address' tag",
 		},
+		{
+			// Address is signed PrefCode
+			Diff{
+				ID:           "your-token",
+				From:         "2021-07-19",
+				To:           "2021-07-19",
+				Address:      "+1",
+				Kind:         []string{},
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"Key: 'Diff.Address' Error:Field validation for 'Address' failed on the 'address' tag",
+		},
 		{
 			// Address is invalid CityCode
 			Diff{
@@ -256,6 +289,32 @@ func TestDiffValidateError(t *testing.T) {
 			},
 			"Key: 'Diff.Address' Error:Field validation for 'Address' failed on the 'address' tag",
 		},
+		{
+			// Address is unknown CityCode in prefecture with full municipality history
+			Diff{
+				ID:           "your-token",
+				From:         "2021-07-19",
+				To:           "2021-07-19",
+				Address:      "10999",
+				Kind:         []string{},
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"Key: 'Diff.Address' Error:Field validation for 'Address' failed on the 'address' tag",
+		},
+		{
+			// Address is former CityCode divided into multiple
+			Diff{
+				ID:           "your-token",
+				From:         "2021-07-19",
+				To:           "2021-07-19",
+				Address:      "22135",
+				Kind:         []string{},
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"Key: 'Diff.Address' Error:Field validation for 'Address' failed on the 'address' tag",
+		},
 		{
 			// Kind contains invalid KindCode
 			Diff{
@@ -362,6 +421,32 @@ func TestDiffURL(t *testing.T) {
 			},
 			"https://api.houjin-bangou.nta.go.jp/4/diff?address=10202&devide=1&from=2021-07-19&id=your-token&to=2021-07-20&type=12",
 		},
+		{
+			// Address is former CityCode
+			Diff{
+				ID:           "your-token",
+				From:         "2021-07-19",
+				To:           "2021-07-20",
+				Address:      "10361",
+				Kind:         []string{},
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"https://api.houjin-bangou.nta.go.jp/4/diff?address=10202&devide=1&from=2021-07-19&id=your-token&to=2021-07-20&type=12",
+		},
+		{
+			// Address is former CityCode not in municipality history
+			Diff{
+				ID:           "your-token",
+				From:         "2021-07-19",
+				To:           "2021-07-20",
+				Address:      "11201",
+				Kind:         []string{},
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"https://api.houjin-bangou.nta.go.jp/4/diff?address=11201&devide=1&from=2021-07-19&id=your-token&to=2021-07-20&type=12",
+		},
 		{
 			// Kind is provided 1 KindCode
 			Diff{
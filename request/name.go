@@ -26,6 +26,7 @@ type Name struct {
 	Target int `validate:"min=1,max=3" url:"target"`
 	// 所在地
 	// 空文字, 都道府県コード(2桁)または都道府県コード+市区町村コード(5桁)
+	// 合併等で廃止された市区町村コードは address.MunicipalityChanges に収録されていれば現在の市区町村コードに変換
 	Address string `validate:"address" url:"address,omitempty"`
 	// 法人種別
 	// 01:国の機関, 02:地方公共団体, 03:設立法人登記, 04:外国会社等・その他
@@ -74,9 +75,12 @@ func (n Name) Validate() error {
 URL 生成
 
 バリデーション処理が必要な場合は別途 Validate メソッドを実行してください。
+所在地に合併等で廃止された団体コードを指定した場合は現在の団体コードに変換します。
+address.MunicipalityChanges に収録されていない変更の団体コードは変換しません。
 */
 func (n Name) URL() (url.URL, error) {
 	var u url.URL
+	n.Address = resolveAddress(n.Address)
 	q, err := query.Values(n)
 	if err != nil {
 		return u, fmt.Errorf("failed to convert query string: %w", err)
@@ -171,6 +171,36 @@ func TestNameValidate(t *testing.T) {
 			Divide:       1,
 			ResponseType: RESPONSE_TYPE,
 		},
+		// Address has former CityCode
+		{
+			ID:           "you-token",
+			Name:         "フィルイン",
+			Mode:         1,
+			Target:       1,
+			Address:      "10361",
+			Kind:         []string{},
+			Change:       false,
+			Close:        false,
+			From:         "",
+			To:           "",
+			Divide:       1,
+			ResponseType: RESPONSE_TYPE,
+		},
+		// Address has former CityCode not in municipality history
+		{
+			ID:           "you-token",
+			Name:         "フィルイン",
+			Mode:         1,
+			Target:       1,
+			Address:      "11201",
+			Kind:         []string{},
+			Change:       false,
+			Close:        false,
+			From:         "",
+			To:           "",
+			Divide:       1,
+			ResponseType: RESPONSE_TYPE,
+		},
 		// Address has 1 KindCode
 		{
 			ID:           "you-token",
@@ -497,6 +527,42 @@ func TestNameValidateError(t *testing.T) {
 			},
 			"Key: 'Name.Address' Error:Field validation for 'Address' failed on the 'address' tag",
 		},
+		{
+			// Address is unknown CityCode in prefecture with full municipality history
+			Name{
+				ID:           "you-token",
+				Name:         "フィルイン",
+				Mode:         1,
+				Target:       1,
+				Address:      "10999",
+				Kind:         []string{},
+				Change:       false,
+				Close:        false,
+				From:         "",
+				To:           "",
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"Key: 'Name.Address' Error:Field validation for 'Address' failed on the 'address' tag",
+		},
+		{
+			// Address is former CityCode divided into multiple
+			Name{
+				ID:           "you-token",
+				Name:         "フィルイン",
+				Mode:         1,
+				Target:       1,
+				Address:      "22135",
+				Kind:         []string{},
+				Change:       false,
+				Close:        false,
+				From:         "",
+				To:           "",
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"Key: 'Name.Address' Error:Field validation for 'Address' failed on the 'address' tag",
+		},
 		{
 			// Kind contains invalid KindCode
 			Name{
@@ -692,6 +758,42 @@ func TestNameURL(t *testing.T) {
 			},
 			"https://api.houjin-bangou.nta.go.jp/4/name?address=10202&change=0&close=0&divide=1&id=you-token&mode=1&name=%E3%83%95%E3%82%A3%E3%83%AB%E3%82%A4%E3%83%B3&target=1&type=12",
 		},
+		{
+			// Address is former CityCode
+			Name{
+				ID:           "you-token",
+				Name:         "フィルイン",
+				Mode:         1,
+				Target:       1,
+				Address:      "10361",
+				Kind:         []string{},
+				Change:       false,
+				Close:        false,
+				From:         "",
+				To:           "",
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"https://api.houjin-bangou.nta.go.jp/4/name?address=10202&change=0&close=0&divide=1&id=you-token&mode=1&name=%E3%83%95%E3%82%A3%E3%83%AB%E3%82%A4%E3%83%B3&target=1&type=12",
+		},
+		{
+			// Address is former CityCode not in municipality history
+			Name{
+				ID:           "you-token",
+				Name:         "フィルイン",
+				Mode:         1,
+				Target:       1,
+				Address:      "11201",
+				Kind:         []string{},
+				Change:       false,
+				Close:        false,
+				From:         "",
+				To:           "",
+				Divide:       1,
+				ResponseType: RESPONSE_TYPE,
+			},
+			"https://api.houjin-bangou.nta.go.jp/4/name?address=11201&change=0&close=0&divide=1&id=you-token&mode=1&name=%E3%83%95%E3%82%A3%E3%83%AB%E3%82%A4%E3%83%B3&target=1&type=12",
+		},
 		{
 			// Kind is provided 1 KindCode
 			Name{
@@ -1,42 +1,6 @@
 package corp
 
-var (
-	// 処理区分
-	processes = map[string]string{
-		"01": "新規",
-		"11": "商号又は名称の変更",
-		"12": "国内所在地の変更",
-		"13": "国外所在地の変更",
-		"21": "登記記録の閉鎖等",
-		"22": "登記記録の復活等",
-		"71": "吸収合併",
-		"72": "吸収合併無効",
-		"81": "商号の登記の抹消",
-		"99": "削除",
-	}
-
-	// 法人種別
-	kinds = map[uint16]string{
-		101: "国の機関",
-		201: "地方公共団体",
-		301: "株式会社",
-		302: "有限会社",
-		303: "合名会社",
-		304: "合資会社",
-		305: "合同会社",
-		399: "その他の設立登記法人",
-		401: "外国会社等",
-		499: "その他",
-	}
-
-	// 登記記録の閉鎖等の事由
-	closeCauses = map[string]string{
-		"01": "精算の結了等",
-		"11": "合併による解散等",
-		"21": "登記官による閉鎖",
-		"31": "その他の精算の結了等",
-	}
-)
+import "github.com/fillin-inc/go-corp/address"
 
 /*
 Response は法人番号システム Web-API から取得できる XML データを扱います。
@@ -67,14 +31,14 @@ type Corporation struct {
 	// 01:新規, 11:商号又は名称の変更, 12:国内所在地の変更, 13: 国外所在地の変更,
 	// 21:登記記録の閉鎖等, 22:登記記録の復活等,
 	// 71:吸収合併, 72:吸収合併無効, 81:商号の登記の抹消, 99:削除
-	Process string `xml:"process"`
+	Process Process `xml:"process"`
 	// 訂正区分
 	// false:訂正以外, true:訂正
 	Correct bool `xml:"correct"`
 	// 更新年月日
 	UpdateDate *Date `xml:"updateDate"`
 	// 変更年月日
-	ChangeDate *Date `xml:"ChangeDate"`
+	ChangeDate *Date `xml:"changeDate"`
 	// 商号または名称
 	Name string `xml:"name"`
 	// 商号または名称イメージID
@@ -82,8 +46,8 @@ type Corporation struct {
 	// 法人種別
 	// 101:国の機関, 201:地方公共団体,
 	// 301:株式会社, 302:有限会社, 303:合名会社, 304:合資会社, 305:合同会社, 399:その他の設立登記法人
-	// 401:外国会社等, 402:その他
-	Kind uint16 `xml:"kind"`
+	// 401:外国会社等, 499:その他
+	Kind Kind `xml:"kind"`
 	// 国内所在地(都道府県)
 	PrefectureName string `xml:"prefectureName"`
 	// 国内所在地(市区町村)
@@ -107,8 +71,8 @@ type Corporation struct {
 	// 登記記録の閉鎖等年月日
 	CloseDate *Date `xml:"closeDate"`
 	// 登記記録の閉鎖等の事由
-	// 01:精算の結了等, 11:合併による解散等, 21:登記官による閉鎖, 31:その他の精算の結了等
-	CloseCause string `xml:"closeCause"`
+	// 01:清算の結了等, 11:合併による解散等, 21:登記官による閉鎖, 31:その他の清算の結了等
+	CloseCause CloseCause `xml:"closeCause"`
 	// 承継先法人番号
 	SuccessorCorporateNumber uint64 `xml:"successorCorporateNumber"`
 	// 変更事由の詳細
@@ -133,25 +97,28 @@ type Corporation struct {
 	Hihyoji bool `xml:"hihyoji"`
 }
 
-// ProcessText は Process(処理区分) の表示用テキストを返します。
+// ProcessText は Process(処理区分) の既定の言語の表示用テキストを返します。未知の処理区分の場合は空文字を返します。
 func (c Corporation) ProcessText() string {
-	return processes[c.Process]
+	if !c.Process.IsValid() {
+		return ""
+	}
+	return c.Process.Label(CurrentLocale())
 }
 
-// KindText は Kind(法人種別) の表示用テキストを返します。
+// KindText は Kind(法人種別) の既定の言語の表示用テキストを返します。未知の法人種別の場合は空文字を返します。
 func (c Corporation) KindText() string {
-	if c.Kind == 0 {
+	if !c.Kind.IsValid() {
 		return ""
 	}
-	return kinds[c.Kind]
+	return c.Kind.Label(CurrentLocale())
 }
 
-// CloseCauseText は CloseCause(登記事項の閉鎖等の事由)の表示用テキストを返します。
+// CloseCauseText は CloseCause(登記事項の閉鎖等の事由)の既定の言語の表示用テキストを返します。未知の事由の場合は空文字を返します。
 func (c Corporation) CloseCauseText() string {
-	if c.CloseCause == "" {
+	if !c.CloseCause.IsValid() {
 		return ""
 	}
-	return closeCauses[c.CloseCause]
+	return c.CloseCause.Label(CurrentLocale())
 }
 
 /*
@@ -164,5 +131,26 @@ Available は法人情報が有効か判定します。
 このデータは実際には利用できないため無効と判定されます。
 */
 func (c Corporation) Available() bool {
-	return c.Process != "99"
+	return c.Process != ProcessDeleted
+}
+
+/*
+Closed は登記記録が閉鎖されているか判定します。
+
+登記記録の閉鎖等年月日(CloseDate)がある場合, または処理区分(Process)が登記記録の閉鎖等・吸収合併の場合に閉鎖とみなします。
+Web-API は閉鎖されていない法人の閉鎖等年月日を空の要素(<closeDate />)で返すため,
+CloseDate が nil でないことだけでは閉鎖と判定できません。
+*/
+func (c Corporation) Closed() bool {
+	return !isZeroDate(c.CloseDate) || c.Process == ProcessClosed || c.Process == ProcessAbsorbed
+}
+
+// Address は国内所在地を address.Address に変換します。
+func (c Corporation) Address() address.Address {
+	return address.Address{
+		PostCode:   c.PostCode,
+		Prefecture: c.PrefectureName,
+		City:       c.CityName,
+		Street:     c.StreetNumber,
+	}
 }
@@ -3,6 +3,7 @@ package corp
 import (
 	"encoding/xml"
 	"testing"
+	"time"
 )
 
 func TestUnmarshalToXML(t *testing.T) {
@@ -132,6 +133,10 @@ func TestUnmarshalToXML(t *testing.T) {
 		t.Errorf("Hihyoji is wrong result:%t expected:%t", corp.Hihyoji, false)
 	}
 
+	if corp.ChangeDate == nil || corp.ChangeDate.String() != "2016-09-05" {
+		t.Errorf("ChangeDate is wrong result:%v expected:%s", corp.ChangeDate, "2016-09-05")
+	}
+
 	corp = res.Corporations[2]
 	if corp.Correct != false {
 		t.Errorf("Correct is wrong result:%t expected:%t", corp.Correct, false)
@@ -255,3 +260,38 @@ func TestAvailable(t *testing.T) {
 		}
 	})
 }
+
+func TestClosed(t *testing.T) {
+	closeDate := Date(time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name     string
+		xml      string
+		c        Corporation
+		expected bool
+	}{
+		{"empty close date", "<corporation><process>01</process><closeDate /></corporation>", Corporation{}, false},
+		{"close date", "<corporation><process>01</process><closeDate>2020-03-31</closeDate></corporation>", Corporation{}, true},
+		{"absorbed", "<corporation><process>71</process><closeDate /></corporation>", Corporation{}, true},
+		{"not decoded", "", Corporation{Process: ProcessNew, CloseDate: &closeDate}, true},
+		{"nil close date", "", Corporation{Process: ProcessNew}, false},
+	}
+	for _, test := range tests {
+		c := test.c
+		if test.xml != "" {
+			if err := xml.Unmarshal([]byte(test.xml), &c); err != nil {
+				t.Fatalf("%s: error! %v", test.name, err)
+			}
+		}
+		if result := c.Closed(); result != test.expected {
+			t.Errorf("%s: result:%v expected:%v", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestAddress(t *testing.T) {
+	c := Corporation{PostCode: "3700069", PrefectureName: "群馬県", CityName: "高崎市", StreetNumber: "飯塚町１４７番地４"}
+	a := c.Address()
+	if a.PostCode != "3700069" || a.Prefecture != "群馬県" || a.City != "高崎市" || a.Street != "飯塚町１４７番地４" {
+		t.Errorf("Address return wrong value result:%+v", a)
+	}
+}
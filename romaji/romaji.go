@@ -0,0 +1,180 @@
+/*
+カタカナ・ひらがなの読みをヘボン式のローマ字に変換するパッケージです。
+
+法人情報のフリガナや市区町村名の読みから英字の表記を生成するために使用します。
+長音は表記しない(マクロンを使わない)方式です。
+
+	romaji.Convert("タカサキ")         // takasaki
+	romaji.Title("フィルイン　ソフト") // Firuin Sofuto
+*/
+package romaji
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/fillin-inc/go-corp/normalize"
+)
+
+// 拗音を含む 2 文字の読み
+var digraphs = map[string]string{
+	"キャ": "kya", "キュ": "kyu", "キョ": "kyo",
+	"ギャ": "gya", "ギュ": "gyu", "ギョ": "gyo",
+	"シャ": "sha", "シュ": "shu", "ショ": "sho", "シェ": "she",
+	"ジャ": "ja", "ジュ": "ju", "ジョ": "jo", "ジェ": "je",
+	"チャ": "cha", "チュ": "chu", "チョ": "cho", "チェ": "che",
+	"ヂャ": "ja", "ヂュ": "ju", "ヂョ": "jo",
+	"ニャ": "nya", "ニュ": "nyu", "ニョ": "nyo",
+	"ヒャ": "hya", "ヒュ": "hyu", "ヒョ": "hyo",
+	"ビャ": "bya", "ビュ": "byu", "ビョ": "byo",
+	"ピャ": "pya", "ピュ": "pyu", "ピョ": "pyo",
+	"ミャ": "mya", "ミュ": "myu", "ミョ": "myo",
+	"リャ": "rya", "リュ": "ryu", "リョ": "ryo",
+	"ファ": "fa", "フィ": "fi", "フェ": "fe", "フォ": "fo",
+	"ウィ": "wi", "ウェ": "we", "ウォ": "wo",
+	"ヴァ": "va", "ヴィ": "vi", "ヴェ": "ve", "ヴォ": "vo",
+	"ティ": "ti", "ディ": "di", "トゥ": "tu", "ドゥ": "du",
+}
+
+// 1 文字の読み
+var monographs = map[rune]string{
+	'ア': "a", 'イ': "i", 'ウ': "u", 'エ': "e", 'オ': "o",
+	'カ': "ka", 'キ': "ki", 'ク': "ku", 'ケ': "ke", 'コ': "ko",
+	'ガ': "ga", 'ギ': "gi", 'グ': "gu", 'ゲ': "ge", 'ゴ': "go",
+	'サ': "sa", 'シ': "shi", 'ス': "su", 'セ': "se", 'ソ': "so",
+	'ザ': "za", 'ジ': "ji", 'ズ': "zu", 'ゼ': "ze", 'ゾ': "zo",
+	'タ': "ta", 'チ': "chi", 'ツ': "tsu", 'テ': "te", 'ト': "to",
+	'ダ': "da", 'ヂ': "ji", 'ヅ': "zu", 'デ': "de", 'ド': "do",
+	'ナ': "na", 'ニ': "ni", 'ヌ': "nu", 'ネ': "ne", 'ノ': "no",
+	'ハ': "ha", 'ヒ': "hi", 'フ': "fu", 'ヘ': "he", 'ホ': "ho",
+	'バ': "ba", 'ビ': "bi", 'ブ': "bu", 'ベ': "be", 'ボ': "bo",
+	'パ': "pa", 'ピ': "pi", 'プ': "pu", 'ペ': "pe", 'ポ': "po",
+	'マ': "ma", 'ミ': "mi", 'ム': "mu", 'メ': "me", 'モ': "mo",
+	'ヤ': "ya", 'ユ': "yu", 'ヨ': "yo",
+	'ラ': "ra", 'リ': "ri", 'ル': "ru", 'レ': "re", 'ロ': "ro",
+	'ワ': "wa", 'ヰ': "i", 'ヱ': "e", 'ヲ': "o", 'ヴ': "vu",
+	'ァ': "a", 'ィ': "i", 'ゥ': "u", 'ェ': "e", 'ォ': "o",
+	'ャ': "ya", 'ュ': "yu", 'ョ': "yo", 'ヮ': "wa",
+}
+
+/*
+Convert はカタカナ(ひらがなを含む)をヘボン式のローマ字に変換します。
+
+長音は表記せず(トウキョウ → tokyo, オオイタ → oita), 促音は次の子音を重ね(ハッチ → hatchi),
+b, m, p の前の撥音は m とします(シンバシ → shimbashi)。
+母音と y の前の撥音はアポストロフィで区切ります(カンオンジ → kan'onji)。
+カナ以外の文字はそのまま出力し, 長音の判定はカナから変換した音節の間でのみ行います(Good → Good)。
+*/
+func Convert(kana string) string {
+	rs := []rune(normalize.ToKatakana(kana))
+
+	var syllables []syllable
+	for i := 0; i < len(rs); i++ {
+		if i+1 < len(rs) {
+			if s, ok := digraphs[string(rs[i:i+2])]; ok {
+				syllables = append(syllables, syllable{s, true})
+				i++
+				continue
+			}
+		}
+		switch r := rs[i]; r {
+		case 'ッ', 'ン', 'ー':
+			syllables = append(syllables, syllable{string(r), true})
+		default:
+			if s, ok := monographs[r]; ok {
+				syllables = append(syllables, syllable{s, true})
+			} else {
+				syllables = append(syllables, syllable{string(r), false})
+			}
+		}
+	}
+
+	var b strings.Builder
+	// 直前に出力したカナの音節(長音の判定に使用)
+	prev := ""
+	for i, s := range syllables {
+		if !s.kana {
+			b.WriteString(s.text)
+			prev = ""
+			continue
+		}
+
+		next := ""
+		if i+1 < len(syllables) && syllables[i+1].kana {
+			next = syllables[i+1].text
+		}
+		switch s.text {
+		case "ッ":
+			switch {
+			case strings.HasPrefix(next, "ch"):
+				b.WriteByte('t')
+			case next != "" && isConsonant(next[0]):
+				b.WriteByte(next[0])
+			}
+			prev = ""
+		case "ン":
+			switch {
+			case next != "" && strings.IndexByte("bmp", next[0]) >= 0:
+				b.WriteByte('m')
+			case next != "" && strings.IndexByte("aiueoy", next[0]) >= 0:
+				b.WriteString("n'")
+			default:
+				b.WriteByte('n')
+			}
+			prev = ""
+		case "ー":
+		default:
+			if longVowel(prev, s.text) {
+				prev = ""
+				continue
+			}
+			b.WriteString(s.text)
+			prev = s.text
+		}
+	}
+	return b.String()
+}
+
+// syllable は Convert で変換する音節です。kana が false の場合はカナ以外の文字をそのまま出力します。
+type syllable struct {
+	text string
+	kana bool
+}
+
+// Capitalize は先頭の文字を大文字にします。
+func Capitalize(s string) string {
+	for i, r := range s {
+		return string(unicode.ToUpper(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
+
+/*
+Title は空白または中黒「・」で区切られた語をそれぞれローマ字に変換し, 先頭を大文字にして半角空白でつなげます。
+
+	Title("ニホン・ソフト") // Nihon Sofuto
+*/
+func Title(kana string) string {
+	words := strings.FieldsFunc(kana, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '・' || r == '･'
+	})
+	for i, w := range words {
+		words[i] = Capitalize(Convert(w))
+	}
+	return strings.Join(words, " ")
+}
+
+// longVowel はカナの音節 prev に続く音節 s が長音となる母音(oo, ou, uu)か判定します。
+func longVowel(prev, s string) bool {
+	switch {
+	case strings.HasSuffix(prev, "o"):
+		return s == "o" || s == "u"
+	case strings.HasSuffix(prev, "u"):
+		return s == "u"
+	}
+	return false
+}
+
+func isConsonant(c byte) bool {
+	return c >= 'a' && c <= 'z' && strings.IndexByte("aiueon", c) < 0
+}
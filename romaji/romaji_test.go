@@ -0,0 +1,58 @@
+package romaji
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	tests := map[string]string{
+		"タカサキ":      "takasaki",
+		"トウキョウ":     "tokyo",
+		"オオイタ":      "oita",
+		"チュウオウ":     "chuo",
+		"ニイガタ":      "niigata",
+		"ハッチョウボリ":   "hatchobori",
+		"ホッカイドウ":    "hokkaido",
+		"シンバシ":      "shimbashi",
+		"ナンブ":       "nambu",
+		"カンオンジ":     "kan'onji",
+		"シンヨコハマ":    "shin'yokohama",
+		"フィルイン":     "firuin",
+		"ふくおか":      "fukuoka",
+		"コーヒー":      "kohi",
+		"ABC":       "ABC",
+		"Good Food": "Good Food",
+		"Woodstock": "Woodstock",
+		"トウキョウCoop": "tokyoCoop",
+		"Soulソウル":   "Soulsoru",
+		"オオuu":      "ouu",
+	}
+	for kana, expected := range tests {
+		if result := Convert(kana); result != expected {
+			t.Errorf("%s: result:%s expected:%s", kana, result, expected)
+		}
+	}
+}
+
+func TestCapitalize(t *testing.T) {
+	if result := Capitalize("takasaki"); result != "Takasaki" {
+		t.Errorf("result is wrong. result:%s", result)
+	}
+	if result := Capitalize(""); result != "" {
+		t.Errorf("result is wrong. result:%s", result)
+	}
+}
+
+func TestTitle(t *testing.T) {
+	tests := map[string]string{
+		"フィルイン":     "Firuin",
+		"ニホン・ソフト":   "Nihon Sofuto",
+		"タカサキ　シンキン": "Takasaki Shinkin",
+		"Good Food": "Good Food",
+		"ソウル Boo":   "Soru Boo",
+		"":          "",
+	}
+	for kana, expected := range tests {
+		if result := Title(kana); result != expected {
+			t.Errorf("%s: result:%s expected:%s", kana, result, expected)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package corp
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// JSON Schema のバージョン
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	dateType        = reflect.TypeOf(Date{})
+	corporationType = reflect.TypeOf(Corporation{})
+)
+
+/*
+JSONSchema は Response と Corporation の JSON 表現の JSON Schema を生成します。
+
+JSON 表現の Go の型から生成するため, MarshalJSON の出力と常に一致します。
+同じ内容を schema/corporation.schema.json に同梱しています。
+*/
+func JSONSchema() ([]byte, error) {
+	root := schemaOf(reflect.TypeOf(responseJSON{}))
+	root["$schema"] = jsonSchemaDraft
+	root["$id"] = "https://github.com/fillin-inc/go-corp/schema/corporation.schema.json"
+	root["title"] = "法人番号システム Web-API レスポンス"
+	root["$defs"] = map[string]interface{}{
+		"corporation": schemaOf(reflect.TypeOf(corporationJSON{})),
+	}
+
+	b, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func schemaOf(t reflect.Type) map[string]interface{} {
+	switch {
+	case t == corporationType:
+		return map[string]interface{}{"$ref": "#/$defs/corporation"}
+	case t == dateType:
+		return map[string]interface{}{"type": "string", "format": "date"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := schemaOf(t.Elem())
+		if typ, ok := s["type"].(string); ok {
+			s["type"] = []string{typ, "null"}
+		}
+		return s
+	case reflect.Struct:
+		props := map[string]interface{}{}
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+
+			prop := schemaOf(f.Type)
+			if desc := f.Tag.Get("desc"); desc != "" {
+				prop["description"] = desc
+			}
+			props[name] = prop
+			required = append(required, name)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           props,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	default:
+		return map[string]interface{}{}
+	}
+}
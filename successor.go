@@ -0,0 +1,265 @@
+package corp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// 承継先の追跡で既定とする最大の深さ(承継先をたどる回数)
+const DefaultSuccessorDepth = 10
+
+var (
+	// ErrSuccessorCycle は承継先の追跡中に同じ法人番号が再び現れた場合のエラーです。
+	ErrSuccessorCycle = errors.New("successor chain contains a cycle")
+	// ErrSuccessorDepth は承継先の追跡が最大の深さを超えた場合のエラーです。
+	ErrSuccessorDepth = errors.New("successor chain exceeds max depth")
+)
+
+// SuccessorChain は法人から承継先をたどった結果です。
+type SuccessorChain struct {
+	// 指定した法人から順に承継先をたどった法人情報
+	Chain []Corporation
+}
+
+// Current は承継先をたどった最後の法人情報を返します。
+func (c SuccessorChain) Current() Corporation {
+	if len(c.Chain) == 0 {
+		return Corporation{}
+	}
+	return c.Chain[len(c.Chain)-1]
+}
+
+// Active は最後の法人が存続しているか判定します。
+func (c SuccessorChain) Active() bool {
+	if len(c.Chain) == 0 {
+		return false
+	}
+	return !c.Current().Closed()
+}
+
+// Numbers は承継先をたどった法人番号を順に返します。
+func (c SuccessorChain) Numbers() []uint64 {
+	numbers := make([]uint64, 0, len(c.Chain))
+	for _, corp := range c.Chain {
+		numbers = append(numbers, corp.CorporateNumber)
+	}
+	return numbers
+}
+
+/*
+SuccessorResolver は承継先法人番号(SuccessorCorporateNumber)をたどり, 現在存続している法人を探します。
+
+取得した法人情報はキャッシュされ, 同じ法人番号に対して ByNumber を繰り返し呼び出すことはありません。
+ゼロ値はそのまま利用でき, NewSuccessorResolver で生成した場合と同じ動作をします。
+複数の goroutine から同時に利用できます。
+*/
+type SuccessorResolver struct {
+	/*
+		承継先をたどる最大の深さ
+
+		指定した法人から承継先をたどる回数の上限です。SuccessorChain には最大で MaxDepth+1 件の法人情報が含まれます。
+		0 以下の場合は DefaultSuccessorDepth を使用します。
+	*/
+	MaxDepth int
+
+	mu    sync.Mutex
+	cache map[uint64]Corporation
+}
+
+// NewSuccessorResolver は SuccessorResolver を生成します。
+func NewSuccessorResolver() *SuccessorResolver {
+	return &SuccessorResolver{
+		MaxDepth: DefaultSuccessorDepth,
+		cache:    map[uint64]Corporation{},
+	}
+}
+
+/*
+Resolve は法人番号から承継先をたどり, 承継先を持たない法人までの SuccessorChain を返します。
+
+承継先が循環している場合は ErrSuccessorCycle を,
+承継先をたどる回数が MaxDepth を超える場合は ErrSuccessorDepth を途中までの SuccessorChain とともに返します。
+*/
+func (r *SuccessorResolver) Resolve(number uint64) (SuccessorChain, error) {
+	var chain SuccessorChain
+	visited := map[uint64]struct{}{}
+	maxDepth := r.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultSuccessorDepth
+	}
+
+	for {
+		if _, ok := visited[number]; ok {
+			return chain, fmt.Errorf("%w: %d", ErrSuccessorCycle, number)
+		}
+		// 次に取得する法人情報は len(chain.Chain) 回目の承継先
+		if len(chain.Chain) > maxDepth {
+			return chain, fmt.Errorf("%w: %d", ErrSuccessorDepth, maxDepth)
+		}
+		visited[number] = struct{}{}
+
+		c, err := r.latest(number)
+		if err != nil {
+			return chain, err
+		}
+		chain.Chain = append(chain.Chain, c)
+
+		if c.SuccessorCorporateNumber == 0 || !c.Closed() {
+			return chain, nil
+		}
+		number = c.SuccessorCorporateNumber
+	}
+}
+
+// Store は取得済みの法人情報をキャッシュに登録します。最新でない法人情報は無視します。
+func (r *SuccessorResolver) Store(corps ...Corporation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range corps {
+		if c.Latest && c.Available() {
+			r.store(c)
+		}
+	}
+}
+
+func (r *SuccessorResolver) latest(number uint64) (Corporation, error) {
+	r.mu.Lock()
+	c, ok := r.cache[number]
+	r.mu.Unlock()
+	if ok {
+		return c, nil
+	}
+
+	res, err := ByNumber(number)
+	if err != nil {
+		return Corporation{}, err
+	}
+
+	for _, c := range res.Corporations {
+		if c.CorporateNumber == number && c.Available() {
+			r.mu.Lock()
+			r.store(c)
+			r.mu.Unlock()
+			return c, nil
+		}
+	}
+	return Corporation{}, fmt.Errorf("corporation not found: %d", number)
+}
+
+// store は法人情報をキャッシュに登録します。r.mu をロックして呼び出してください。
+func (r *SuccessorResolver) store(c Corporation) {
+	if r.cache == nil {
+		r.cache = map[uint64]Corporation{}
+	}
+	r.cache[c.CorporateNumber] = c
+}
+
+/*
+MergerGraph は法人情報から構築した吸収合併等による承継関係のグラフです。
+
+期間指定検索や全件データの法人情報を追加することで, ある法人の承継元をまとめて調べることができます。
+*/
+type MergerGraph struct {
+	successors   map[uint64]uint64
+	predecessors map[uint64][]uint64
+}
+
+// NewMergerGraph は法人情報から MergerGraph を生成します。
+func NewMergerGraph(corps ...Corporation) *MergerGraph {
+	g := &MergerGraph{
+		successors:   map[uint64]uint64{},
+		predecessors: map[uint64][]uint64{},
+	}
+	g.Add(corps...)
+	return g
+}
+
+// Add は承継先法人番号を持つ法人情報をグラフに追加します。
+func (g *MergerGraph) Add(corps ...Corporation) {
+	for _, c := range corps {
+		from, to := c.CorporateNumber, c.SuccessorCorporateNumber
+		if to == 0 || from == to {
+			continue
+		}
+		if current, ok := g.successors[from]; ok {
+			if current == to {
+				continue
+			}
+			g.removePredecessor(current, from)
+		}
+		g.successors[from] = to
+		g.predecessors[to] = append(g.predecessors[to], from)
+	}
+}
+
+// AddResponse はレスポンスに含まれる法人情報をグラフに追加します。
+func (g *MergerGraph) AddResponse(res Response) {
+	g.Add(res.Corporations...)
+}
+
+// Successor は法人の直接の承継先を返します。
+func (g *MergerGraph) Successor(number uint64) (uint64, bool) {
+	n, ok := g.successors[number]
+	return n, ok
+}
+
+// Predecessors は法人を直接の承継先とする法人番号を昇順で返します。
+func (g *MergerGraph) Predecessors(number uint64) []uint64 {
+	numbers := append([]uint64{}, g.predecessors[number]...)
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// AllPredecessors は承継関係をさかのぼったすべての承継元の法人番号を昇順で返します。
+func (g *MergerGraph) AllPredecessors(number uint64) []uint64 {
+	visited := map[uint64]struct{}{number: {}}
+	queue := []uint64{number}
+	var numbers []uint64
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, p := range g.predecessors[n] {
+			if _, ok := visited[p]; ok {
+				continue
+			}
+			visited[p] = struct{}{}
+			numbers = append(numbers, p)
+			queue = append(queue, p)
+		}
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+/*
+Resolve はグラフ上で承継先をたどり, 承継先を持たない法人番号を返します。
+
+承継先が循環している場合は ErrSuccessorCycle を返します。
+*/
+func (g *MergerGraph) Resolve(number uint64) (uint64, error) {
+	visited := map[uint64]struct{}{}
+	for {
+		if _, ok := visited[number]; ok {
+			return number, fmt.Errorf("%w: %d", ErrSuccessorCycle, number)
+		}
+		visited[number] = struct{}{}
+
+		next, ok := g.successors[number]
+		if !ok {
+			return number, nil
+		}
+		number = next
+	}
+}
+
+func (g *MergerGraph) removePredecessor(successor, predecessor uint64) {
+	ps := g.predecessors[successor]
+	for i, p := range ps {
+		if p == predecessor {
+			g.predecessors[successor] = append(ps[:i], ps[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package corp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSuccessorResolverResolve(t *testing.T) {
+	closeDate := Date(time.Date(2022, 4, 1, 0, 0, 0, 0, currentLocation()))
+	absorbed := func(number, successor uint64) Corporation {
+		return Corporation{CorporateNumber: number, Process: "71", CloseDate: &closeDate, CloseCause: "11", SuccessorCorporateNumber: successor, Latest: true}
+	}
+
+	t.Run("Chain", func(t *testing.T) {
+		ts := testServer("./testdata/response/by_number.xml")
+		defer ts.Close()
+
+		SetAppID("your-token")
+		setTestEnvToRequest(ts)
+
+		r := NewSuccessorResolver()
+		r.Store(absorbed(1010401089234, 6011001106696), absorbed(6011001106696, testFillinCorpNum))
+
+		chain, err := r.Resolve(1010401089234)
+		if err != nil {
+			t.Fatalf("error! %v", err)
+		}
+
+		expected := []uint64{1010401089234, 6011001106696, testFillinCorpNum}
+		if !reflect.DeepEqual(chain.Numbers(), expected) {
+			t.Errorf("Numbers is wrong. result:%v expected:%v", chain.Numbers(), expected)
+		}
+
+		if !chain.Active() || chain.Current().Name != "株式会社フィルイン" {
+			t.Errorf("Current is wrong. result:%+v", chain.Current())
+		}
+
+		// 取得した法人情報はキャッシュされる
+		ts.Close()
+		if _, err := r.Resolve(testFillinCorpNum); err != nil {
+			t.Errorf("cached corporation is not used. %v", err)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		r := NewSuccessorResolver()
+		r.Store(absorbed(1010401089234, 6011001106696), absorbed(6011001106696, 1010401089234))
+
+		_, err := r.Resolve(1010401089234)
+		if !errors.Is(err, ErrSuccessorCycle) {
+			t.Errorf("Unexpected error received: %v", err)
+		}
+	})
+
+	t.Run("Depth", func(t *testing.T) {
+		r := NewSuccessorResolver()
+		r.MaxDepth = 1
+		r.Store(absorbed(1010401089234, 6011001106696), absorbed(6011001106696, 8010403022079))
+
+		chain, err := r.Resolve(1010401089234)
+		if !errors.Is(err, ErrSuccessorDepth) {
+			t.Errorf("Unexpected error received: %v", err)
+		}
+
+		if len(chain.Chain) != 2 {
+			t.Errorf("chain length is wrong. result:%d expected:%d", len(chain.Chain), 2)
+		}
+	})
+
+	t.Run("Depth Boundary", func(t *testing.T) {
+		var r SuccessorResolver
+		r.MaxDepth = 2
+		r.Store(absorbed(1010401089234, 6011001106696), absorbed(6011001106696, 8010403022079), absorbed(8010403022079, 3011103003992))
+
+		// 2 回たどった法人が存続していれば成功
+		r.Store(Corporation{CorporateNumber: 3011103003992, Latest: true})
+		chain, err := r.Resolve(6011001106696)
+		if err != nil || len(chain.Chain) != 3 {
+			t.Errorf("Resolve is wrong. length:%d err:%v", len(chain.Chain), err)
+		}
+
+		// 3 回目は取得せずにエラー
+		chain, err = r.Resolve(1010401089234)
+		if !errors.Is(err, ErrSuccessorDepth) {
+			t.Errorf("Unexpected error received: %v", err)
+		}
+		if len(chain.Chain) != 3 {
+			t.Errorf("chain length is wrong. result:%d expected:%d", len(chain.Chain), 3)
+		}
+	})
+
+	t.Run("Zero Value", func(t *testing.T) {
+		var r SuccessorResolver
+		chainCorps := []Corporation{absorbed(1010401089234, 6011001106696)}
+		number := uint64(6011001106696)
+		for _, next := range []uint64{8010403022079, 3011103003992, 1010001000006, 2010001000004, 3010001000002, 4010001000000, 5010001000007, 6010001000005, 7010001000003, 8010001000001} {
+			chainCorps = append(chainCorps, absorbed(number, next))
+			number = next
+		}
+		r.Store(chainCorps...)
+		r.Store(Corporation{CorporateNumber: number, Latest: true})
+
+		// DefaultSuccessorDepth(10) 回を超える承継先はたどらない
+		chain, err := r.Resolve(1010401089234)
+		if !errors.Is(err, ErrSuccessorDepth) || len(chain.Chain) != DefaultSuccessorDepth+1 {
+			t.Errorf("Resolve is wrong. length:%d err:%v", len(chain.Chain), err)
+		}
+		if chain, err := r.Resolve(6011001106696); err != nil || len(chain.Chain) != DefaultSuccessorDepth+1 {
+			t.Errorf("Resolve is wrong. length:%d err:%v", len(chain.Chain), err)
+		}
+	})
+}
+
+func TestMergerGraph(t *testing.T) {
+	g := NewMergerGraph(
+		Corporation{CorporateNumber: 1010401089234, SuccessorCorporateNumber: 6011001106696},
+		Corporation{CorporateNumber: 8010403022079, SuccessorCorporateNumber: 6011001106696},
+		Corporation{CorporateNumber: 6011001106696, SuccessorCorporateNumber: testFillinCorpNum},
+		Corporation{CorporateNumber: testGunmaCorpNum},
+	)
+
+	if n, ok := g.Successor(1010401089234); !ok || n != 6011001106696 {
+		t.Errorf("Successor is wrong. result:%d", n)
+	}
+
+	if _, ok := g.Successor(testGunmaCorpNum); ok {
+		t.Error("Successor return true for corporation without successor.")
+	}
+
+	expected := []uint64{1010401089234, 8010403022079}
+	if !reflect.DeepEqual(g.Predecessors(6011001106696), expected) {
+		t.Errorf("Predecessors is wrong. result:%v expected:%v", g.Predecessors(6011001106696), expected)
+	}
+
+	expected = []uint64{1010401089234, 6011001106696, 8010403022079}
+	if !reflect.DeepEqual(g.AllPredecessors(testFillinCorpNum), expected) {
+		t.Errorf("AllPredecessors is wrong. result:%v expected:%v", g.AllPredecessors(testFillinCorpNum), expected)
+	}
+
+	if n, err := g.Resolve(8010403022079); err != nil || n != testFillinCorpNum {
+		t.Errorf("Resolve is wrong. result:%d err:%v", n, err)
+	}
+
+	// 承継先の付け替え
+	g.Add(Corporation{CorporateNumber: 8010403022079, SuccessorCorporateNumber: testGunmaCorpNum})
+	if !reflect.DeepEqual(g.Predecessors(6011001106696), []uint64{1010401089234}) {
+		t.Errorf("Predecessors is wrong. result:%v", g.Predecessors(6011001106696))
+	}
+
+	g.Add(Corporation{CorporateNumber: testGunmaCorpNum, SuccessorCorporateNumber: 8010403022079})
+	if _, err := g.Resolve(testGunmaCorpNum); !errors.Is(err, ErrSuccessorCycle) {
+		t.Errorf("Unexpected error received: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package corp
+
+import "sort"
+
+/*
+Timeline は変更履歴を含む法人情報を 1 法人ごとに時系列で整理したものです。
+
+ByNumberWithHistory で取得したレスポンスから Timelines で生成します。
+*/
+type Timeline struct {
+	// 法人番号
+	CorporateNumber uint64
+	// 変更年月日, 一連番号の順に並べた法人の状態
+	Entries []TimelineEntry
+}
+
+/*
+TimelineEntry は Timeline 上の 1 つの状態です。
+
+訂正区分(Correct)が true で直前の状態と変更年月日が同じレコードは新たな変更ではなく
+直前の状態の訂正として扱い, State を置き換えたうえで Corrections に記録します。
+*/
+type TimelineEntry struct {
+	// 訂正を反映した法人情報
+	State Corporation
+	// 変更を登録したレコード
+	Original Corporation
+	// 訂正のレコード
+	Corrections []Corporation
+}
+
+// Transition は連続する 2 つの状態の間の変更です。
+type Transition struct {
+	// 変更前の法人情報
+	From Corporation
+	// 変更後の法人情報
+	To Corporation
+	// 値が異なるフィールド名
+	Changes []string
+}
+
+/*
+Timelines はレスポンスに含まれる法人情報を法人番号ごとに Timeline にまとめます。
+
+Timeline はレスポンス中で最初に出現した順に並びます。
+処理区分が 99(削除) の法人情報は含みません。
+*/
+func Timelines(res Response) []Timeline {
+	var numbers []uint64
+	records := map[uint64][]Corporation{}
+	for _, c := range res.Corporations {
+		if !c.Available() {
+			continue
+		}
+		if _, ok := records[c.CorporateNumber]; !ok {
+			numbers = append(numbers, c.CorporateNumber)
+		}
+		records[c.CorporateNumber] = append(records[c.CorporateNumber], c)
+	}
+
+	timelines := make([]Timeline, 0, len(numbers))
+	for _, n := range numbers {
+		timelines = append(timelines, NewTimeline(records[n]))
+	}
+	return timelines
+}
+
+/*
+NewTimeline は 1 法人の法人情報から Timeline を生成します。
+
+異なる法人番号の法人情報が含まれる場合は先頭の法人番号のもののみ使用します。
+*/
+func NewTimeline(records []Corporation) Timeline {
+	var t Timeline
+	if len(records) == 0 {
+		return t
+	}
+	t.CorporateNumber = records[0].CorporateNumber
+
+	sorted := make([]Corporation, 0, len(records))
+	for _, c := range records {
+		if c.CorporateNumber == t.CorporateNumber && c.Available() {
+			sorted = append(sorted, c)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := dateString(sorted[i].ChangeDate), dateString(sorted[j].ChangeDate)
+		if a != b {
+			return a < b
+		}
+		return sorted[i].SequenceNumber < sorted[j].SequenceNumber
+	})
+
+	for _, c := range sorted {
+		last := len(t.Entries) - 1
+		if c.Correct && last >= 0 && sameDate(t.Entries[last].State.ChangeDate, c.ChangeDate) {
+			t.Entries[last].State = c
+			t.Entries[last].Corrections = append(t.Entries[last].Corrections, c)
+			continue
+		}
+		t.Entries = append(t.Entries, TimelineEntry{State: c, Original: c})
+	}
+	return t
+}
+
+// States は訂正を反映した法人の状態を時系列順に返します。
+func (t Timeline) States() []Corporation {
+	states := make([]Corporation, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		states = append(states, e.State)
+	}
+	return states
+}
+
+// Current は最新の状態を返します。状態が存在しない場合は false を返します。
+func (t Timeline) Current() (Corporation, bool) {
+	if len(t.Entries) == 0 {
+		return Corporation{}, false
+	}
+	return t.Entries[len(t.Entries)-1].State, true
+}
+
+// Transitions は連続する状態の間の変更を時系列順に返します。
+func (t Timeline) Transitions() []Transition {
+	var transitions []Transition
+	for i := 1; i < len(t.Entries); i++ {
+		from, to := t.Entries[i-1].State, t.Entries[i].State
+		transitions = append(transitions, Transition{
+			From:    from,
+			To:      to,
+			Changes: Compare(from, to).Fields(),
+		})
+	}
+	return transitions
+}
@@ -0,0 +1,116 @@
+package corp
+
+import (
+	"encoding/xml"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimelines(t *testing.T) {
+	res := testResponse(t, "./testdata/response/by_number_with_history.xml")
+	timelines := Timelines(res)
+
+	if len(timelines) != 1 {
+		t.Fatalf("timelines length is wrong. result:%d expected:%d", len(timelines), 1)
+	}
+
+	tl := timelines[0]
+	if tl.CorporateNumber != testFillinCorpNum {
+		t.Errorf("CorporateNumber is wrong. result:%d expected:%d", tl.CorporateNumber, testFillinCorpNum)
+	}
+
+	postCodes := []string{"3700849", "3700813", "3700069"}
+	states := tl.States()
+	if len(states) != len(postCodes) {
+		t.Fatalf("states length is wrong. result:%d expected:%d", len(states), len(postCodes))
+	}
+	for i, postCode := range postCodes {
+		if states[i].PostCode != postCode {
+			t.Errorf("%d: PostCode is wrong. result:%s expected:%s", i, states[i].PostCode, postCode)
+		}
+	}
+
+	current, ok := tl.Current()
+	if !ok || !current.Latest {
+		t.Errorf("Current is wrong. result:%+v", current)
+	}
+
+	transitions := tl.Transitions()
+	if len(transitions) != 2 {
+		t.Fatalf("transitions length is wrong. result:%d expected:%d", len(transitions), 2)
+	}
+
+	expected := []string{"StreetNumber", "PostCode"}
+	if !reflect.DeepEqual(transitions[0].Changes, expected) {
+		t.Errorf("Changes is wrong. result:%v expected:%v", transitions[0].Changes, expected)
+	}
+}
+
+func TestNewTimeline(t *testing.T) {
+	d := func(year int, month time.Month, day int) *Date {
+		date := Date(time.Date(year, month, day, 0, 0, 0, 0, currentLocation()))
+		return &date
+	}
+
+	t.Run("Correction", func(t *testing.T) {
+		records := []Corporation{
+			{SequenceNumber: 3, CorporateNumber: testFillinCorpNum, Process: "11", Correct: true, ChangeDate: d(2020, 4, 1), Name: "株式会社フィルイン"},
+			{SequenceNumber: 1, CorporateNumber: testFillinCorpNum, Process: "01", ChangeDate: d(2016, 9, 5), Name: "株式会社フイルイン"},
+			{SequenceNumber: 2, CorporateNumber: testFillinCorpNum, Process: "11", ChangeDate: d(2020, 4, 1), Name: "株式会社フィルインー"},
+			{SequenceNumber: 4, CorporateNumber: testGunmaCorpNum, Process: "01", ChangeDate: d(2020, 4, 1), Name: "群馬県"},
+		}
+
+		tl := NewTimeline(records)
+
+		if len(tl.Entries) != 2 {
+			t.Fatalf("entries length is wrong. result:%d expected:%d", len(tl.Entries), 2)
+		}
+
+		e := tl.Entries[1]
+		if e.State.Name != "株式会社フィルイン" {
+			t.Errorf("corrected State is wrong. result:%s", e.State.Name)
+		}
+
+		if e.Original.Name != "株式会社フィルインー" {
+			t.Errorf("Original is wrong. result:%s", e.Original.Name)
+		}
+
+		if len(e.Corrections) != 1 || e.Corrections[0].SequenceNumber != 3 {
+			t.Errorf("Corrections is wrong. result:%+v", e.Corrections)
+		}
+	})
+
+	t.Run("Deleted Records", func(t *testing.T) {
+		tl := NewTimeline([]Corporation{
+			{CorporateNumber: testFillinCorpNum, Process: "01", ChangeDate: d(2016, 9, 5)},
+			{CorporateNumber: testFillinCorpNum, Process: "99"},
+		})
+
+		if len(tl.Entries) != 1 {
+			t.Errorf("entries length is wrong. result:%d expected:%d", len(tl.Entries), 1)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if _, ok := NewTimeline(nil).Current(); ok {
+			t.Error("Current return true for empty timeline.")
+		}
+	})
+}
+
+func testResponse(t *testing.T, xmlPath string) Response {
+	t.Helper()
+
+	data, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", xmlPath, err)
+	}
+
+	var res Response
+	if err := xml.Unmarshal(data, &res); err != nil {
+		t.Fatalf("failed to parse XML: %v", err)
+	}
+	return res
+}
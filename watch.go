@@ -0,0 +1,373 @@
+package corp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fillin-inc/go-corp/request"
+)
+
+// 法人番号指定検索で一度に指定できる法人番号の上限
+const maxNumbersPerRequest = 10
+
+// Watchlist は変更を監視する法人番号の集合です。
+//
+// 複数の goroutine から同時に利用できます。
+type Watchlist struct {
+	mu      sync.RWMutex
+	numbers map[uint64]struct{}
+}
+
+// NewWatchlist は法人番号を指定して Watchlist を生成します。
+func NewWatchlist(numbers ...uint64) *Watchlist {
+	w := &Watchlist{numbers: make(map[uint64]struct{}, len(numbers))}
+	for _, n := range numbers {
+		w.numbers[n] = struct{}{}
+	}
+	return w
+}
+
+/*
+LoadWatchlist は 1 行に 1 つの法人番号が記載されたデータから Watchlist を生成します。
+
+空行と # から始まる行は無視します。法人番号は ParseCorporateNumber で読み込むため, 全角数字やハイフン区切りも使用できます。
+チェックデジットが一致しない法人番号が含まれる場合はエラーを返します。
+*/
+func LoadWatchlist(r io.Reader) (*Watchlist, error) {
+	w := NewWatchlist()
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		n, err := ParseCorporateNumber(s)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		w.numbers[n.Uint64()] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Save は LoadWatchlist で読み込める形式で法人番号を昇順に書き出します。
+func (w *Watchlist) Save(wr io.Writer) error {
+	bw := bufio.NewWriter(wr)
+	for _, n := range w.Numbers() {
+		if _, err := fmt.Fprintln(bw, n); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Add は法人番号を監視対象に追加します。
+func (w *Watchlist) Add(numbers ...uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, n := range numbers {
+		w.numbers[n] = struct{}{}
+	}
+}
+
+// Remove は法人番号を監視対象から除外します。
+func (w *Watchlist) Remove(numbers ...uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, n := range numbers {
+		delete(w.numbers, n)
+	}
+}
+
+// Contains は法人番号が監視対象か判定します。
+func (w *Watchlist) Contains(number uint64) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	_, ok := w.numbers[number]
+	return ok
+}
+
+// Len は監視対象の法人番号の件数を返します。
+func (w *Watchlist) Len() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return len(w.numbers)
+}
+
+// Numbers は監視対象の法人番号を昇順で返します。
+func (w *Watchlist) Numbers() []uint64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	numbers := make([]uint64, 0, len(w.numbers))
+	for n := range w.numbers {
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+/*
+WatchEvent は監視対象の法人に変更があった場合に通知されるイベントです。
+
+New は期間指定検索で取得した法人情報です。
+Old は変更履歴から取得した変更前の法人情報で, 新規の法人など変更前の情報が存在しない場合は nil です。
+*/
+type WatchEvent struct {
+	// 法人番号
+	CorporateNumber uint64
+	// 変更前の法人情報
+	Old *Corporation
+	// 変更後の法人情報
+	New Corporation
+}
+
+/*
+Watcher は期間指定検索(DiffSearch)を定期的に実行し, Watchlist に含まれる法人の変更を通知します。
+
+Poll で任意の期間を 1 回だけ検索することも, Run や Watch で一定間隔の監視を行うこともできます。
+*/
+type Watcher struct {
+	// 監視対象
+	Watchlist *Watchlist
+	// 所在地
+	// 空文字, 都道府県コード(2桁)または都道府県コード+市区町村コード(5桁)
+	Address string
+	// 検索間隔
+	Interval time.Duration
+	// 検索エラー時の処理
+	// nil の場合 Run はエラーを返して終了します。
+	OnError func(error)
+
+	since time.Time
+	// 前回の検索で通知済みの変更
+	seen map[string]struct{}
+}
+
+// NewWatcher は Watcher を生成します。監視は当日の変更から開始します。
+func NewWatcher(list *Watchlist, address string, interval time.Duration) *Watcher {
+	return &Watcher{
+		Watchlist: list,
+		Address:   address,
+		Interval:  interval,
+		since:     today(),
+		seen:      map[string]struct{}{},
+	}
+}
+
+// Since は次回の検索を指定した日付から開始するよう設定します。
+func (w *Watcher) Since(t time.Time) {
+	w.since = truncateDate(t)
+}
+
+/*
+Poll は from から to までの期間指定検索を行い, Watchlist に含まれる法人の変更を返します。
+
+分割されたレスポンスはすべて取得します。
+変更前の法人情報は ByNumberWithHistory で取得した変更履歴から補完します。
+*/
+func (w *Watcher) Poll(from, to time.Time) ([]WatchEvent, error) {
+	var hits []Corporation
+	for divide := 1; ; divide++ {
+		res, err := diffSearch(from.Format(DATE_FORMAT), to.Format(DATE_FORMAT), w.Address, divide)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range res.Corporations {
+			if w.Watchlist.Contains(c.CorporateNumber) {
+				hits = append(hits, c)
+			}
+		}
+
+		if res.DivideNumber >= res.DevideSize {
+			break
+		}
+	}
+
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	hist, err := histories(hits)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]WatchEvent, 0, len(hits))
+	for _, c := range hits {
+		events = append(events, WatchEvent{
+			CorporateNumber: c.CorporateNumber,
+			Old:             previousRecord(hist[c.CorporateNumber], c),
+			New:             c,
+		})
+	}
+	return events, nil
+}
+
+/*
+Run は Interval ごとに前回の検索日から当日までの期間指定検索を行い, 変更を handler に通知します。
+
+同一の変更は 1 度だけ通知されます。
+ctx がキャンセルされると ctx.Err() を返して終了します。
+*/
+func (w *Watcher) Run(ctx context.Context, handler func(WatchEvent)) error {
+	if w.Interval <= 0 {
+		return errors.New("watcher interval must be positive")
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.tick(handler); err != nil {
+			if w.OnError == nil {
+				return err
+			}
+			w.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+Watch は Run をバックグラウンドで実行し, 変更をチャネルで通知します。
+
+Run が終了するとイベント用のチャネルは閉じられ, 終了理由がエラー用のチャネルに送信されます。
+*/
+func (w *Watcher) Watch(ctx context.Context) (<-chan WatchEvent, <-chan error) {
+	events := make(chan WatchEvent)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		errc <- w.Run(ctx, func(e WatchEvent) {
+			select {
+			case events <- e:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	return events, errc
+}
+
+func (w *Watcher) tick(handler func(WatchEvent)) error {
+	to := today()
+	events, err := w.Poll(w.since, to)
+	if err != nil {
+		return err
+	}
+
+	// 当日分は次回も検索されるため, 今回の検索結果を通知済みとして記録する
+	seen := make(map[string]struct{}, len(events))
+	for _, e := range events {
+		key := eventKey(e.New)
+		seen[key] = struct{}{}
+		if _, ok := w.seen[key]; ok {
+			continue
+		}
+		handler(e)
+	}
+
+	w.since = to
+	w.seen = seen
+	return nil
+}
+
+// diffSearch は分割番号を指定して期間指定検索を行います。
+func diffSearch(from string, to string, address string, divide int) (Response, error) {
+	builder := request.NewDiff(appID, from, to, address, []string{}, divide)
+	return responseByURLBuilder(builder)
+}
+
+// histories は法人ごとの変更履歴を取得します。
+func histories(corps []Corporation) (map[uint64][]Corporation, error) {
+	var numbers []uint64
+	ret := map[uint64][]Corporation{}
+	for _, c := range corps {
+		if _, ok := ret[c.CorporateNumber]; ok {
+			continue
+		}
+		ret[c.CorporateNumber] = nil
+		numbers = append(numbers, c.CorporateNumber)
+	}
+
+	for i := 0; i < len(numbers); i += maxNumbersPerRequest {
+		end := i + maxNumbersPerRequest
+		if end > len(numbers) {
+			end = len(numbers)
+		}
+
+		res, err := ByNumberWithHistory(numbers[i:end]...)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.Corporations {
+			ret[c.CorporateNumber] = append(ret[c.CorporateNumber], c)
+		}
+	}
+	return ret, nil
+}
+
+// previousRecord は変更履歴から c の直前の法人情報を探します。
+func previousRecord(history []Corporation, c Corporation) *Corporation {
+	idx := -1
+	for i, h := range history {
+		if h.Process == c.Process && sameDate(h.UpdateDate, c.UpdateDate) && sameDate(h.ChangeDate, c.ChangeDate) {
+			idx = i
+		}
+	}
+	// 一致する履歴がない場合は最新の履歴を変更後とみなす
+	if idx == -1 {
+		idx = len(history) - 1
+	}
+	if idx <= 0 {
+		return nil
+	}
+
+	prev := history[idx-1]
+	return &prev
+}
+
+func eventKey(c Corporation) string {
+	return fmt.Sprintf("%d:%s:%s:%s", c.CorporateNumber, string(c.Process), dateString(c.UpdateDate), dateString(c.ChangeDate))
+}
+
+func sameDate(a, b *Date) bool {
+	return dateString(a) == dateString(b)
+}
+
+func dateString(d *Date) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+func today() time.Time {
+	return truncateDate(time.Now())
+}
+
+func truncateDate(t time.Time) time.Time {
+	t = t.In(currentLocation())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, currentLocation())
+}
@@ -0,0 +1,226 @@
+package corp
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchlist(t *testing.T) {
+	list := NewWatchlist(testGunmaCorpNum)
+	list.Add(testFillinCorpNum)
+
+	if list.Len() != 2 {
+		t.Errorf("Len is wrong. result:%d expected:%d", list.Len(), 2)
+	}
+
+	if !list.Contains(testFillinCorpNum) {
+		t.Errorf("Contains return false. number:%d", testFillinCorpNum)
+	}
+
+	expected := []uint64{testFillinCorpNum, testGunmaCorpNum}
+	if !reflect.DeepEqual(list.Numbers(), expected) {
+		t.Errorf("Numbers is wrong. result:%v expected:%v", list.Numbers(), expected)
+	}
+
+	list.Remove(testGunmaCorpNum)
+	if list.Contains(testGunmaCorpNum) {
+		t.Errorf("Contains return true. number:%d", testGunmaCorpNum)
+	}
+}
+
+func TestLoadWatchlist(t *testing.T) {
+	t.Run("Save and Load", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := NewWatchlist(testGunmaCorpNum, testFillinCorpNum).Save(&buf); err != nil {
+			t.Fatalf("failed to save: %v", err)
+		}
+
+		if buf.String() != "5070001032626\n7000020100005\n" {
+			t.Errorf("saved data is wrong. result:%q", buf.String())
+		}
+
+		list, err := LoadWatchlist(strings.NewReader("# counterparties\n\n" + buf.String()))
+		if err != nil {
+			t.Fatalf("failed to load: %v", err)
+		}
+
+		if list.Len() != 2 || !list.Contains(testFillinCorpNum) || !list.Contains(testGunmaCorpNum) {
+			t.Errorf("loaded numbers are wrong. result:%v", list.Numbers())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []string{
+			"abc\n",
+			"1234\n",
+			"4070001032626\n",
+		}
+
+		for i, test := range tests {
+			if _, err := LoadWatchlist(strings.NewReader(test)); err == nil {
+				t.Errorf("%d: No error occurred.", i)
+			}
+		}
+	})
+}
+
+func TestEventKeyUsesChangeDate(t *testing.T) {
+	data, err := os.ReadFile("./testdata/response/by_number_with_history.xml")
+	if err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+
+	// 修正前のタグ(ChangeDate)では Web-API のレスポンスの変更年月日を取得できない
+	var old struct {
+		Corporations []struct {
+			ChangeDate *Date `xml:"ChangeDate"`
+		} `xml:"corporation"`
+	}
+	if err := xml.Unmarshal(data, &old); err != nil {
+		t.Fatalf("failed to parse XML: %v", err)
+	}
+	for i, c := range old.Corporations {
+		if c.ChangeDate != nil {
+			t.Errorf("%d: ChangeDate is decoded with old tag. result:%v", i, c.ChangeDate)
+		}
+	}
+
+	res := testResponse(t, "./testdata/response/by_number_with_history.xml")
+	expected := []string{
+		"5070001032626:01:2018-05-08:2016-09-05",
+		"5070001032626:12:2018-05-08:2018-05-02",
+		"5070001032626:12:2021-06-09:2021-06-02",
+	}
+	for i, c := range res.Corporations {
+		if result := eventKey(c); result != expected[i] {
+			t.Errorf("%d: key is wrong. result:%s expected:%s", i, result, expected[i])
+		}
+	}
+
+	// 変更年月日を含めて一致した履歴の直前を変更前の法人情報とする
+	c := res.Corporations[1]
+	if prev := previousRecord(res.Corporations, c); prev == nil || !sameDate(prev.ChangeDate, res.Corporations[0].ChangeDate) {
+		t.Errorf("previous record is wrong. result:%v", prev)
+	}
+}
+
+func TestWatcherPoll(t *testing.T) {
+	ts := testRouteServer(map[string]string{
+		"/4/diff": "./testdata/response/diff_search.xml",
+		"/4/num":  "./testdata/response/by_number_with_history.xml",
+	})
+	defer ts.Close()
+
+	SetAppID("your-token")
+	setTestEnvToRequest(ts)
+
+	t.Run("Watched", func(t *testing.T) {
+		w := NewWatcher(NewWatchlist(testFillinCorpNum), "10202", time.Hour)
+		day := time.Date(2021, 6, 9, 0, 0, 0, 0, currentLocation())
+		events, err := w.Poll(day, day)
+		if err != nil {
+			t.Fatalf("error! %v", err)
+		}
+
+		if len(events) != 1 {
+			t.Fatalf("events length is wrong. result:%d expected:%d", len(events), 1)
+		}
+
+		e := events[0]
+		if e.CorporateNumber != testFillinCorpNum {
+			t.Errorf("CorporateNumber is wrong. result:%d expected:%d", e.CorporateNumber, testFillinCorpNum)
+		}
+
+		if e.New.StreetNumber != "飯塚町１４７番地４" {
+			t.Errorf("New.StreetNumber is wrong. result:%s", e.New.StreetNumber)
+		}
+
+		if e.Old == nil {
+			t.Fatal("Old is nil.")
+		}
+
+		if e.Old.StreetNumber != "本町４８番地" {
+			t.Errorf("Old.StreetNumber is wrong. result:%s", e.Old.StreetNumber)
+		}
+	})
+
+	t.Run("Not Watched", func(t *testing.T) {
+		w := NewWatcher(NewWatchlist(testGunmaCorpNum), "", time.Hour)
+		day := time.Date(2021, 6, 9, 0, 0, 0, 0, currentLocation())
+		events, err := w.Poll(day, day)
+		if err != nil {
+			t.Fatalf("error! %v", err)
+		}
+
+		if len(events) != 0 {
+			t.Errorf("events length is wrong. result:%d expected:%d", len(events), 0)
+		}
+	})
+}
+
+func TestWatcherWatch(t *testing.T) {
+	ts := testRouteServer(map[string]string{
+		"/4/diff": "./testdata/response/diff_search.xml",
+		"/4/num":  "./testdata/response/by_number_with_history.xml",
+	})
+	defer ts.Close()
+
+	SetAppID("your-token")
+	setTestEnvToRequest(ts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := NewWatcher(NewWatchlist(testFillinCorpNum), "", 10*time.Millisecond)
+	events, errc := w.Watch(ctx)
+
+	e, ok := <-events
+	if !ok {
+		t.Fatalf("events channel closed. err:%v", <-errc)
+	}
+	if e.CorporateNumber != testFillinCorpNum {
+		t.Errorf("CorporateNumber is wrong. result:%d expected:%d", e.CorporateNumber, testFillinCorpNum)
+	}
+
+	// 同一の変更は再通知されない
+	select {
+	case e, ok := <-events:
+		if ok {
+			t.Errorf("duplicated event received. %+v", e)
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range events {
+	}
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("Unexpected error received: %v", err)
+	}
+}
+
+func testRouteServer(routes map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		xmlPath, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		data, _ := os.ReadFile(xmlPath)
+		_, err := w.Write(data)
+		if err != nil {
+			panic(err)
+		}
+	}))
+}